@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	"io"
 	"io/fs"
 	"os"
 	"path"
@@ -51,6 +50,10 @@ func main() {
 	responseFiles := []string{}
 	headerFiles := []string{}
 	filepath.WalkDir(path.Join(inputDir, KafkaMessageSpecPath), func(currentPath string, d fs.DirEntry, err error) error {
+		if d.IsDir() {
+			return nil
+		}
+
 		if strings.HasSuffix(currentPath, RequestSuffix) {
 			requestFiles = append(requestFiles, currentPath)
 		} else if strings.HasSuffix(currentPath, ResponseSuffix) {
@@ -73,14 +76,7 @@ func main() {
 		fmt.Printf("processing %d files...", len(set))
 
 		for _, file := range set {
-			fp, err := os.Open(file)
-			if err != nil {
-				fmt.Printf("error opening file: %v\n", err)
-				os.Exit(1)
-			}
-			defer fp.Close()
-
-			bytes, err := io.ReadAll(fp)
+			bytes, err := os.ReadFile(file)
 			if err != nil {
 				fmt.Printf("error reading file: %v\n", err)
 				os.Exit(1)
@@ -109,7 +105,7 @@ func main() {
 			if len(parts) <= 1 {
 				fileName = fileNameWithExt
 			} else {
-				fileName = parts[1]
+				fileName = parts[0]
 			}
 
 			err = codegen.Generate(outputDir, fileName, next)
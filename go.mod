@@ -1,5 +1,8 @@
 module github.com/ethanmoffat/kafka-protocol
 
-go 1.20
+go 1.22
 
-require github.com/google/uuid v1.5.0 // direct
+require (
+	github.com/google/uuid v1.5.0 // direct
+	github.com/klauspost/compress v1.18.0
+)
@@ -0,0 +1,350 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethanmoffat/kafka-protocol/internal/jsonmodel"
+	"github.com/ethanmoffat/kafka-protocol/internal/jsonmodel/versions"
+)
+
+// fieldMode describes how a field is serialized in a particular version.
+type fieldMode struct {
+	present  bool
+	nullable bool
+	flexible bool // strings, bytes and arrays use the compact encoding
+}
+
+// segment is a contiguous range of versions in which a field is serialized the same way.
+type segment struct {
+	lowest  int
+	highest int
+	mode    fieldMode
+}
+
+// segments returns the versions in which a field is serialized, either in the regular field
+// sequence of its struct or, if tagged is set, in the tagged field section.
+func (g *generator) segments(f jsonmodel.FieldSpec, tagged bool) []segment {
+	var segs []segment
+	valid := g.spec.ValidVersions
+	flexible := g.spec.FlexibleVersions
+	if f.FlexibleVersions != nil {
+		flexible = *f.FlexibleVersions
+	}
+
+	compact := hasCompactEncoding(parseFieldType(f.Type))
+
+	for v := valid.Lowest(); v <= valid.Highest(); v++ {
+		mode := fieldMode{
+			present:  f.Versions.Contains(v) && f.TaggedVersions.Contains(v) == tagged,
+			nullable: f.NullableVersions.Contains(v),
+			flexible: compact && flexible.Contains(v),
+		}
+
+		if n := len(segs); n > 0 && segs[n-1].mode == mode {
+			segs[n-1].highest = v
+		} else {
+			segs = append(segs, segment{lowest: v, highest: v, mode: mode})
+		}
+	}
+
+	present := segs[:0]
+	for _, seg := range segs {
+		if seg.mode.present {
+			present = append(present, seg)
+		}
+	}
+	return present
+}
+
+// flexibleVersions returns the valid versions of the message that are flexible.
+func (g *generator) flexibleVersions() versions.Range {
+	return g.spec.FlexibleVersions.Intersect(g.spec.ValidVersions)
+}
+
+// versionCondition returns the condition that selects versions lowest-highest out of the bound
+// versions, or an empty string if every bound version is selected.
+func versionCondition(lowest, highest int, bound versions.Range) string {
+	switch {
+	case lowest <= bound.Lowest() && highest >= bound.Highest():
+		return ""
+	case lowest == highest:
+		return fmt.Sprintf("version == %d", lowest)
+	case lowest > bound.Lowest() && highest < bound.Highest():
+		return fmt.Sprintf("version >= %d && version <= %d", lowest, highest)
+	case lowest > bound.Lowest():
+		return fmt.Sprintf("version >= %d", lowest)
+	default:
+		return fmt.Sprintf("version <= %d", highest)
+	}
+}
+
+// writeVersioned emits body once per segment, guarded by the versions of the segment out of
+// the bound versions.
+func (g *generator) writeVersioned(segs []segment, bound versions.Range, body func(mode fieldMode) error) error {
+	for i, seg := range segs {
+		cond := versionCondition(seg.lowest, seg.highest, bound)
+		if cond == "" {
+			return body(seg.mode)
+		}
+
+		if i == 0 {
+			g.p("if %s {", cond)
+		} else {
+			g.p("} else if %s {", cond)
+		}
+		if err := body(seg.mode); err != nil {
+			return err
+		}
+	}
+
+	if len(segs) > 0 {
+		g.p("}")
+	}
+	return nil
+}
+
+// check emits a call that returns an error, propagating the error to the caller.
+func (g *generator) check(format string, args ...any) {
+	g.p("if err := %s; err != nil {", fmt.Sprintf(format, args...))
+	g.p("return err")
+	g.p("}")
+}
+
+// assign emits a call that returns a value and an error, storing the value in target.
+func (g *generator) assign(target string, format string, args ...any) {
+	g.p("if %s, err = %s; err != nil {", target, fmt.Sprintf(format, args...))
+	g.p("return err")
+	g.p("}")
+}
+
+func (g *generator) writeEncode(s *structDef) error {
+	g.p("func (m *%s) encode(w *protocol.MessageWriter, version int) error {", s.goName)
+	for _, f := range s.fields {
+		err := g.writeVersioned(g.segments(f, false), g.spec.ValidVersions, func(mode fieldMode) error {
+			return g.writeEncodeField(f, mode)
+		})
+		if err != nil {
+			return fmt.Errorf("field %s.%s: %w", s.name, f.Name, err)
+		}
+	}
+	if err := g.writeEncodeTaggedFields(s); err != nil {
+		return err
+	}
+	g.p("return nil")
+	g.p("}")
+	g.p("")
+	return nil
+}
+
+func (g *generator) writeEncodeField(f jsonmodel.FieldSpec, mode fieldMode) error {
+	t := parseFieldType(f.Type)
+	expr := "m." + f.Name
+
+	if t.array {
+		length := arrayLengthMethod(mode)
+		if mode.nullable {
+			g.p("if %s == nil {", expr)
+			g.check("w.Write%s(-1)", length)
+			g.p("} else {")
+		}
+		g.check("w.Write%s(len(%s))", length, expr)
+		g.p("for i := range %s {", expr)
+		if err := g.writeEncodeValue(t.name, expr+"[i]", false, fieldMode{present: true, flexible: mode.flexible}); err != nil {
+			return err
+		}
+		g.p("}")
+		if mode.nullable {
+			g.p("}")
+		}
+		return nil
+	}
+
+	return g.writeEncodeValue(t.name, expr, !f.NullableVersions.Empty(), mode)
+}
+
+// writeEncodeValue emits the encoding of a single value. pointer indicates that the Go type of
+// expr is a pointer, which is the case for strings and structs that are nullable in any version.
+// A nil pointer in a version where the field is not nullable is encoded as the default value.
+func (g *generator) writeEncodeValue(typ string, expr string, pointer bool, mode fieldMode) error {
+	if method, ok := primitiveMethod(typ, mode); ok {
+		if typ == "string" && pointer && !mode.nullable {
+			g.p("if %s == nil {", expr)
+			g.check("w.Write%s(\"\")", method)
+			g.p("} else {")
+			g.check("w.Write%s(*%s)", method, expr)
+			g.p("}")
+		} else {
+			g.check("w.Write%s(%s)", method, expr)
+		}
+		return nil
+	}
+
+	s, ok := g.byName[typ]
+	if !ok {
+		return fmt.Errorf("unknown type %s", typ)
+	}
+
+	switch {
+	case mode.nullable:
+		g.p("if %s == nil {", expr)
+		g.check("w.WriteInt8(-1)")
+		g.p("} else {")
+		g.check("w.WriteInt8(1)")
+		g.check("%s.encode(w, version)", expr)
+		g.p("}")
+	case pointer:
+		g.p("if %s == nil {", expr)
+		g.p("var v %s", s.goName)
+		g.p("v.SetDefaults()")
+		g.check("v.encode(w, version)")
+		g.p("} else {")
+		g.check("%s.encode(w, version)", expr)
+		g.p("}")
+	default:
+		g.check("%s.encode(w, version)", expr)
+	}
+	return nil
+}
+
+func (g *generator) writeDecode(s *structDef) error {
+	g.p("func (m *%s) decode(r *protocol.MessageReader, version int) (err error) {", s.goName)
+	g.p("m.SetDefaults()")
+	for _, f := range s.fields {
+		err := g.writeVersioned(g.segments(f, false), g.spec.ValidVersions, func(mode fieldMode) error {
+			return g.writeDecodeField(f, mode)
+		})
+		if err != nil {
+			return fmt.Errorf("field %s.%s: %w", s.name, f.Name, err)
+		}
+	}
+	if err := g.writeDecodeTaggedFields(s); err != nil {
+		return err
+	}
+	g.p("return nil")
+	g.p("}")
+	g.p("")
+	return nil
+}
+
+func (g *generator) writeDecodeField(f jsonmodel.FieldSpec, mode fieldMode) error {
+	t := parseFieldType(f.Type)
+	expr := "m." + f.Name
+
+	if t.array {
+		elem, err := g.elemGoType(t.name)
+		if err != nil {
+			return err
+		}
+
+		g.p("if n, err := r.Read%s(); err != nil {", arrayLengthMethod(mode))
+		g.p("return err")
+		g.p("} else if n < 0 {")
+		if mode.nullable {
+			g.p("%s = nil", expr)
+		} else {
+			g.p("return fmt.Errorf(\"non-nullable field %s was serialized as null\")", f.Name)
+			g.imports["fmt"] = true
+		}
+		g.p("} else {")
+		g.p("%s = make([]%s, 0)", expr, elem)
+		g.p("for i := 0; i < n; i++ {")
+		g.p("var e %s", elem)
+		if err := g.writeDecodeValue(t.name, "e", false, fieldMode{present: true, flexible: mode.flexible}); err != nil {
+			return err
+		}
+		g.p("%s = append(%s, e)", expr, expr)
+		g.p("}")
+		g.p("}")
+		return nil
+	}
+
+	return g.writeDecodeValue(t.name, expr, !f.NullableVersions.Empty(), mode)
+}
+
+func (g *generator) writeDecodeValue(typ string, expr string, pointer bool, mode fieldMode) error {
+	if method, ok := primitiveMethod(typ, mode); ok {
+		if typ == "string" && pointer && !mode.nullable {
+			g.p("if v, err := r.Read%s(); err != nil {", method)
+			g.p("return err")
+			g.p("} else {")
+			g.p("%s = &v", expr)
+			g.p("}")
+		} else {
+			g.assign(expr, "r.Read%s()", method)
+		}
+		return nil
+	}
+
+	s, ok := g.byName[typ]
+	if !ok {
+		return fmt.Errorf("unknown type %s", typ)
+	}
+
+	switch {
+	case mode.nullable:
+		g.p("if present, err := r.ReadInt8(); err != nil {")
+		g.p("return err")
+		g.p("} else if present < 0 {")
+		g.p("%s = nil", expr)
+		g.p("} else {")
+		g.p("%s = new(%s)", expr, s.goName)
+		g.check("%s.decode(r, version)", expr)
+		g.p("}")
+	case pointer:
+		g.p("%s = new(%s)", expr, s.goName)
+		g.check("%s.decode(r, version)", expr)
+	default:
+		g.check("%s.decode(r, version)", expr)
+	}
+	return nil
+}
+
+// primitiveMethod returns the suffix of the MessageReader/MessageWriter methods that read and
+// write a primitive type in the given mode. It returns false for struct types.
+func primitiveMethod(typ string, mode fieldMode) (string, bool) {
+	var method string
+	switch typ {
+	case "bool", "int8", "int16", "uint16", "int32", "uint32", "int64", "float64":
+		return strings.ToUpper(typ[:1]) + typ[1:], true
+	case "uuid":
+		return "Uuid", true
+	case "string":
+		method = "String"
+	case "bytes":
+		method = "Bytes"
+	case "records":
+		method = "Bytes"
+		if mode.nullable {
+			method = "Records"
+		}
+	default:
+		return "", false
+	}
+
+	if mode.nullable && typ != "records" {
+		method = "Nullable" + method
+	}
+	if mode.flexible {
+		method = "Compact" + method
+	}
+	return method, true
+}
+
+func hasCompactEncoding(t fieldType) bool {
+	switch {
+	case t.array:
+		return true
+	case t.name == "string", t.name == "bytes", t.name == "records":
+		return true
+	default:
+		return false
+	}
+}
+
+func arrayLengthMethod(mode fieldMode) string {
+	if mode.flexible {
+		return "CompactArrayLength"
+	}
+	return "ArrayLength"
+}
@@ -0,0 +1,114 @@
+package codegen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethanmoffat/kafka-protocol/internal/jsonmodel"
+)
+
+// writeSetDefaults emits SetDefaults, which resets every field of the struct to the default
+// value from the spec. Fields without an explicit default use the Go zero value.
+func (g *generator) writeSetDefaults(s *structDef) error {
+	var inits []string
+	if g.isMessage(s) {
+		inits = append(inits, "version: m.version")
+		if g.hasApiKey() {
+			inits = append(inits, "correlationId: m.correlationId")
+		}
+	}
+
+	var nested []string
+	for _, f := range s.fields {
+		t := parseFieldType(f.Type)
+		if !t.array && t.isStruct() && f.NullableVersions.Empty() {
+			nested = append(nested, f.Name)
+			continue
+		}
+
+		lit, err := defaultLiteral(f)
+		if err != nil {
+			return fmt.Errorf("field %s.%s: %w", s.name, f.Name, err)
+		}
+		if lit != "" {
+			inits = append(inits, fmt.Sprintf("%s: %s", f.Name, lit))
+		}
+	}
+
+	g.p("// SetDefaults resets all fields of %s to their default values.", s.goName)
+	g.p("func (m *%s) SetDefaults() {", s.goName)
+	g.p("*m = %s{%s}", s.goName, strings.Join(inits, ", "))
+	for _, name := range nested {
+		g.p("m.%s.SetDefaults()", name)
+	}
+	g.p("}")
+	g.p("")
+	return nil
+}
+
+// defaultLiteral returns the Go literal for the default value of a field, or an empty string if
+// the default is the Go zero value.
+func defaultLiteral(f jsonmodel.FieldSpec) (string, error) {
+	t := parseFieldType(f.Type)
+	if t.array || t.isStruct() {
+		return "", nil
+	}
+
+	var def string
+	switch v := f.Default.(type) {
+	case nil:
+		return "", nil
+	case string:
+		def = strings.TrimSpace(v)
+	case bool:
+		def = strconv.FormatBool(v)
+	case float64:
+		def = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return "", fmt.Errorf("unsupported default %v", v)
+	}
+
+	switch t.name {
+	case "bool":
+		b, err := strconv.ParseBool(def)
+		if err != nil || !b {
+			return "", err
+		}
+		return "true", nil
+	case "int8", "int16", "int32", "int64":
+		n, err := strconv.ParseInt(def, 0, 64)
+		if err != nil || n == 0 {
+			return "", err
+		}
+		return strconv.FormatInt(n, 10), nil
+	case "uint16", "uint32":
+		n, err := strconv.ParseUint(def, 0, 64)
+		if err != nil || n == 0 {
+			return "", err
+		}
+		return strconv.FormatUint(n, 10), nil
+	case "float64":
+		n, err := strconv.ParseFloat(def, 64)
+		if err != nil || n == 0 {
+			return "", err
+		}
+		return strconv.FormatFloat(n, 'g', -1, 64), nil
+	case "string":
+		if def == "null" && !f.NullableVersions.Empty() {
+			return "", nil
+		}
+		if def == "" {
+			return "", nil
+		}
+		if !f.NullableVersions.Empty() {
+			return fmt.Sprintf("func() *string { s := %q; return &s }()", def), nil
+		}
+		return strconv.Quote(def), nil
+	default:
+		if def == "" || def == "null" {
+			return "", nil
+		}
+		return "", fmt.Errorf("unsupported default %q for type %s", def, t.name)
+	}
+}
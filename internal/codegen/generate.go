@@ -1,7 +1,275 @@
 package codegen
 
-import "github.com/ethanmoffat/kafka-protocol/internal/jsonmodel"
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/ethanmoffat/kafka-protocol/internal/jsonmodel"
+)
+
+const (
+	protocolImport = "github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	uuidImport     = "github.com/google/uuid"
+)
 
 func Generate(outRoot string, inFileName string, message jsonmodel.MessageSpec) error {
+	g, err := newGenerator(message)
+	if err != nil {
+		return fmt.Errorf("%s: %w", inFileName, err)
+	}
+
+	src, err := g.generate(inFileName)
+	if err != nil {
+		return fmt.Errorf("%s: %w", inFileName, err)
+	}
+
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: formatting generated code: %w", inFileName, err)
+	}
+
+	return os.WriteFile(path.Join(outRoot, toSnakeCase(inFileName)+".go"), formatted, 0644)
+}
+
+type generator struct {
+	spec    jsonmodel.MessageSpec
+	structs []*structDef          // every struct emitted for the message, in declaration order
+	byName  map[string]*structDef // structs keyed by their name in the spec
+
+	imports        map[string]bool
+	needsIsDefault map[string]bool // structs that are compared to their default value
+	body           bytes.Buffer
+}
+
+type structDef struct {
+	name   string // name of the struct in the spec
+	goName string // name of the generated Go type
+	usage  string // describes where the struct is used, for the type comment
+	fields []jsonmodel.FieldSpec
+}
+
+func newGenerator(spec jsonmodel.MessageSpec) (*generator, error) {
+	g := &generator{
+		spec:           spec,
+		byName:         make(map[string]*structDef),
+		imports:        make(map[string]bool),
+		needsIsDefault: make(map[string]bool),
+	}
+
+	g.addStruct(&structDef{name: spec.Name, goName: spec.Name, fields: spec.Fields})
+	if err := g.collectStructs(spec.Name, spec.Fields); err != nil {
+		return nil, err
+	}
+
+	for _, common := range spec.CommonStructs {
+		goName := g.structGoName(common.Name)
+		g.addStruct(&structDef{name: common.Name, goName: goName, usage: "is a common struct of " + spec.Name, fields: common.Fields})
+		if err := g.collectStructs(goName, common.Fields); err != nil {
+			return nil, err
+		}
+	}
+
+	return g, g.validate()
+}
+
+func (g *generator) addStruct(s *structDef) {
+	g.structs = append(g.structs, s)
+	g.byName[s.name] = s
+}
+
+func (g *generator) collectStructs(parent string, fields []jsonmodel.FieldSpec) error {
+	for _, f := range fields {
+		t := parseFieldType(f.Type)
+		if !t.isStruct() || len(f.Fields) == 0 {
+			continue
+		}
+
+		if _, ok := g.byName[t.name]; ok {
+			return fmt.Errorf("struct %s is defined more than once", t.name)
+		}
+
+		goName := g.structGoName(t.name)
+		g.addStruct(&structDef{name: t.name, goName: goName, usage: fmt.Sprintf("is the type of %s.%s", parent, f.Name), fields: f.Fields})
+		if err := g.collectStructs(goName, f.Fields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate ensures that every struct type referenced by a field has a definition.
+func (g *generator) validate() error {
+	for _, s := range g.structs {
+		for _, f := range s.fields {
+			t := parseFieldType(f.Type)
+			if !t.isStruct() {
+				continue
+			}
+			if _, ok := g.byName[t.name]; !ok {
+				return fmt.Errorf("field %s.%s references unknown struct %s", s.name, f.Name, t.name)
+			}
+		}
+	}
+	return nil
+}
+
+// structGoName prefixes nested struct names with the message name. Nested struct names are
+// only unique within a message, but all messages are generated into the same package.
+func (g *generator) structGoName(name string) string {
+	if strings.HasPrefix(name, g.spec.Name) {
+		return name
+	}
+	return g.spec.Name + name
+}
+
+func (g *generator) generate(inFileName string) ([]byte, error) {
+	g.imports[protocolImport] = true
+
+	for _, s := range g.structs {
+		if err := g.writeStruct(s); err != nil {
+			return nil, err
+		}
+		if g.isMessage(s) {
+			g.writeMessageMethods(s)
+		}
+		if err := g.writeSetDefaults(s); err != nil {
+			return nil, err
+		}
+		if err := g.writeEncode(s); err != nil {
+			return nil, err
+		}
+		if err := g.writeDecode(s); err != nil {
+			return nil, err
+		}
+	}
+
+	// isDefault is generated last since it may be needed by structs emitted before the struct
+	// that references them, and generating it may require it for further nested structs.
+	for written := make(map[string]bool); len(written) < len(g.needsIsDefault); {
+		for _, s := range g.structs {
+			if g.needsIsDefault[s.name] && !written[s.name] {
+				written[s.name] = true
+				if err := g.writeIsDefault(s); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by protocol-gen-kafka from %s.json. DO NOT EDIT.\n\n", inFileName)
+	fmt.Fprintf(&out, "package messages\n\n")
+
+	if len(g.imports) > 0 {
+		imports := make([]string, 0, len(g.imports))
+		for imp := range g.imports {
+			imports = append(imports, imp)
+		}
+		sort.Strings(imports)
+
+		fmt.Fprintf(&out, "import (\n")
+		for i, imp := range imports {
+			// standard library imports are grouped before third party imports
+			if i > 0 && !isStdImport(imp) && isStdImport(imports[i-1]) {
+				fmt.Fprintf(&out, "\n")
+			}
+			fmt.Fprintf(&out, "%q\n", imp)
+		}
+		fmt.Fprintf(&out, ")\n\n")
+	}
+
+	out.Write(g.body.Bytes())
+	return out.Bytes(), nil
+}
+
+func (g *generator) p(format string, args ...any) {
+	fmt.Fprintf(&g.body, format, args...)
+	g.body.WriteByte('\n')
+}
+
+func (g *generator) writeStruct(s *structDef) error {
+	if g.isMessage(s) {
+		if err := g.checkMessageFields(s); err != nil {
+			return err
+		}
+		g.writeMessageComment()
+	} else {
+		g.writeComment(fmt.Sprintf("%s %s.", s.goName, s.usage))
+	}
+
+	if err := g.validateTaggedFields(s); err != nil {
+		return err
+	}
+
+	g.p("type %s struct {", s.goName)
+	if g.isMessage(s) {
+		g.p("version int")
+		if g.hasApiKey() {
+			g.p("correlationId int")
+		}
+		g.p("")
+	}
+	for _, f := range s.fields {
+		goType, err := g.goType(f)
+		if err != nil {
+			return fmt.Errorf("field %s.%s: %w", s.name, f.Name, err)
+		}
+
+		if f.About != "" {
+			g.writeComment(f.About)
+		}
+		g.p("%s %s", f.Name, goType)
+	}
+	if g.isFlexible() {
+		g.p("")
+		g.p("// %s holds tagged fields that are not known to this version of the generated", unknownTaggedFields)
+		g.p("// code. They are written back out when the struct is encoded.")
+		g.p("%s []protocol.TaggedField", unknownTaggedFields)
+	}
+	g.p("}")
+	g.p("")
 	return nil
 }
+
+func (g *generator) writeMessageComment() {
+	var comment string
+	if g.spec.ApiKey != nil {
+		comment = fmt.Sprintf("%s is the %s for ApiKey %d.", g.spec.Name, strings.ToLower(g.spec.Type.String()), *g.spec.ApiKey)
+	} else {
+		comment = fmt.Sprintf("%s is a %s.", g.spec.Name, strings.ToLower(g.spec.Type.String()))
+	}
+	g.writeComment(comment)
+	g.p("//")
+	g.p("// Valid versions: %s. Flexible versions: %s.", g.spec.ValidVersions, g.spec.FlexibleVersions)
+}
+
+func (g *generator) writeComment(text string) {
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		g.p("// %s", strings.TrimSpace(line))
+	}
+}
+
+func isStdImport(imp string) bool {
+	return !strings.Contains(strings.Split(imp, "/")[0], ".")
+}
+
+func toSnakeCase(s string) string {
+	var sb strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
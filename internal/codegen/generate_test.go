@@ -0,0 +1,171 @@
+package codegen
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethanmoffat/kafka-protocol/internal/jsonmodel"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func readSpec(t *testing.T, file string) jsonmodel.MessageSpec {
+	t.Helper()
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec jsonmodel.MessageSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		t.Fatalf("%s: %v", file, err)
+	}
+	return spec
+}
+
+// TestGenerateGolden generates the fixture specs in testdata and compares the output with the
+// golden files next to them. Run with -update to rewrite the golden files after changing the
+// generator, and review the diff.
+func TestGenerateGolden(t *testing.T) {
+	specs, err := filepath.Glob("testdata/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(specs) == 0 {
+		t.Fatal("no fixture specs in testdata")
+	}
+
+	for _, file := range specs {
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+		t.Run(name, func(t *testing.T) {
+			out := t.TempDir()
+			if err := Generate(out, name, readSpec(t, file)); err != nil {
+				t.Fatal(err)
+			}
+			got, err := os.ReadFile(filepath.Join(out, toSnakeCase(name)+".go"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			golden := filepath.Join("testdata", toSnakeCase(name)+".go.golden")
+			if *update {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("generated code differs from %s; run go test -update and review the diff", golden)
+			}
+		})
+	}
+}
+
+func TestGenerateFeatures(t *testing.T) {
+	tests := []struct {
+		golden string
+		want   []string
+	}{
+		// flexible versions switch to compact encodings
+		{"fixture_request.go.golden", []string{
+			"if version <= 1 {\n\t\tif err := w.WriteString(m.Name)",
+			"} else if version >= 2 {\n\t\tif err := w.WriteCompactString(m.Name)",
+			"w.WriteCompactArrayLength(len(m.Items))",
+		}},
+		// nullable structs are pointers preceded by a presence byte
+		{"fixture_request.go.golden", []string{
+			"Config *FixtureRequestConfig",
+			"if m.Config == nil {\n\t\t\tif err := w.WriteInt8(-1)",
+		}},
+		// tagged fields are only written when they differ from their default, and unknown tags
+		// are kept
+		{"fixture_request.go.golden", []string{
+			"Priority: 5",
+			"m.Priority != 5",
+			"UnknownTaggedFields []protocol.TaggedField",
+		}},
+		// common structs are prefixed with the message name, and headers may have fields named
+		// like message methods
+		{"fixture_header.go.golden", []string{
+			"type FixtureHeaderPrincipal struct",
+			"Delegates []FixtureHeaderPrincipal",
+			"CorrelationId int32",
+		}},
+	}
+
+	for _, tt := range tests {
+		data, err := os.ReadFile(filepath.Join("testdata", tt.golden))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s does not contain %q", tt.golden, want)
+			}
+		}
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		want string
+	}{
+		{
+			name: "unknown struct",
+			spec: `{"apiKey": 1, "type": "request", "name": "BadRequest", "validVersions": "0",
+				"fields": [{"name": "Thing", "type": "Missing", "versions": "0+"}]}`,
+			want: "unknown struct Missing",
+		},
+		{
+			name: "duplicate struct",
+			spec: `{"apiKey": 1, "type": "request", "name": "BadRequest", "validVersions": "0",
+				"fields": [
+					{"name": "A", "type": "Thing", "versions": "0+", "fields": [{"name": "X", "type": "int8", "versions": "0+"}]},
+					{"name": "B", "type": "Thing", "versions": "0+", "fields": [{"name": "Y", "type": "int8", "versions": "0+"}]}
+				]}`,
+			want: "defined more than once",
+		},
+		{
+			name: "method conflict",
+			spec: `{"apiKey": 1, "type": "request", "name": "BadRequest", "validVersions": "0",
+				"fields": [{"name": "Version", "type": "int8", "versions": "0+"}]}`,
+			want: "conflicts with a generated method",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var spec jsonmodel.MessageSpec
+			if err := json.Unmarshal([]byte(tt.spec), &spec); err != nil {
+				t.Fatal(err)
+			}
+			err := Generate(t.TempDir(), "BadRequest", spec)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Generate() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"ProduceRequest":                   "produce_request",
+		"AlterUserScramCredentialsRequest": "alter_user_scram_credentials_request",
+		"RequestHeader":                    "request_header",
+		"Sha256":                           "sha256",
+	}
+	for in, want := range tests {
+		if got := toSnakeCase(in); got != want {
+			t.Errorf("toSnakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/ethanmoffat/kafka-protocol/internal/jsonmodel/message"
+)
+
+// messageMethods are the methods generated for top-level messages, which may not be shadowed by
+// fields from the spec.
+var messageMethods = map[string]bool{
+	"ApiKey":           true,
+	"Version":          true,
+	"SetVersion":       true,
+	"CorrelationId":    true,
+	"SetCorrelationId": true,
+	"Marshal":          true,
+	"Unmarshal":        true,
+	"Encode":           true,
+	"Decode":           true,
+	"SetDefaults":      true,
+}
+
+func (g *generator) isMessage(s *structDef) bool {
+	return s.name == g.spec.Name
+}
+
+func (g *generator) hasApiKey() bool {
+	return g.spec.ApiKey != nil
+}
+
+func (g *generator) checkMessageFields(s *structDef) error {
+	for _, f := range s.fields {
+		if messageMethods[f.Name] && (g.hasApiKey() || f.Name != "CorrelationId") {
+			return fmt.Errorf("field %s.%s conflicts with a generated method", s.name, f.Name)
+		}
+	}
+	return nil
+}
+
+func (g *generator) writeMessageMethods(s *structDef) {
+	valid := g.spec.ValidVersions
+	name := s.goName
+
+	if g.hasApiKey() && (g.spec.Type == message.Request || g.spec.Type == message.Response) {
+		g.p("func init() {")
+		switch g.spec.Type {
+		case message.Request:
+			flexible := -1
+			if g.isFlexible() {
+				flexible = g.spec.FlexibleVersions.Lowest()
+			}
+			g.p("protocol.RegisterRequest(protocol.ApiKey(%d), %d, %d, %d, func(version int) protocol.Message {", *g.spec.ApiKey, valid.Lowest(), valid.Highest(), flexible)
+		case message.Response:
+			g.p("protocol.RegisterResponse(protocol.ApiKey(%d), func(version int) protocol.Message {", *g.spec.ApiKey)
+		}
+		g.p("return New%s(version)", name)
+		g.p("})")
+		g.p("}")
+		g.p("")
+	}
+
+	g.p("func New%s(version int) *%s {", name, name)
+	g.p("m := &%s{version: version}", name)
+	g.p("m.SetDefaults()")
+	g.p("return m")
+	g.p("}")
+	g.p("")
+
+	if g.hasApiKey() {
+		g.p("func (m *%s) ApiKey() protocol.ApiKey {", name)
+		g.p("return protocol.ApiKey(%d)", *g.spec.ApiKey)
+		g.p("}")
+		g.p("")
+	}
+
+	g.p("func (m *%s) Version() int {", name)
+	g.p("return m.version")
+	g.p("}")
+	g.p("")
+	g.p("func (m *%s) SetVersion(version int) {", name)
+	g.p("m.version = version")
+	g.p("}")
+	g.p("")
+
+	if g.hasApiKey() {
+		g.p("func (m *%s) CorrelationId() int {", name)
+		g.p("return m.correlationId")
+		g.p("}")
+		g.p("")
+		g.p("func (m *%s) SetCorrelationId(correlationId int) {", name)
+		g.p("m.correlationId = correlationId")
+		g.p("}")
+		g.p("")
+	}
+
+	g.p("// Marshal encodes the message at its version. It returns nil if the message cannot be")
+	g.p("// encoded; use Encode to get the error.")
+	g.p("func (m *%s) Marshal() []byte {", name)
+	g.p("var buf bytes.Buffer")
+	g.p("if err := m.Encode(protocol.NewMessageWriter(&buf)); err != nil {")
+	g.p("return nil")
+	g.p("}")
+	g.p("return buf.Bytes()")
+	g.p("}")
+	g.p("")
+
+	g.p("// Unmarshal decodes the message at its version.")
+	g.p("func (m *%s) Unmarshal(b []byte) error {", name)
+	g.p("return m.Decode(protocol.NewMessageReader(bytes.NewReader(b)))")
+	g.p("}")
+	g.p("")
+
+	g.p("func (m *%s) Encode(w *protocol.MessageWriter) error {", name)
+	g.p("if m.version < %d || m.version > %d {", valid.Lowest(), valid.Highest())
+	g.p("return fmt.Errorf(\"%%w: %s v%%d\", protocol.ErrVersionNotImplemented, m.version)", name)
+	g.p("}")
+	g.p("return m.encode(w, m.version)")
+	g.p("}")
+	g.p("")
+
+	g.p("func (m *%s) Decode(r *protocol.MessageReader) error {", name)
+	g.p("if m.version < %d || m.version > %d {", valid.Lowest(), valid.Highest())
+	g.p("return fmt.Errorf(\"%%w: %s v%%d\", protocol.ErrVersionNotImplemented, m.version)", name)
+	g.p("}")
+	g.p("return m.decode(r, m.version)")
+	g.p("}")
+	g.p("")
+
+	g.imports["bytes"] = true
+	g.imports["fmt"] = true
+}
@@ -0,0 +1,237 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethanmoffat/kafka-protocol/internal/jsonmodel"
+)
+
+const unknownTaggedFields = "UnknownTaggedFields"
+
+func (g *generator) isFlexible() bool {
+	return !g.flexibleVersions().Empty()
+}
+
+// taggedFields returns the fields of a struct that are tagged in any valid version.
+func (g *generator) taggedFields(s *structDef) []jsonmodel.FieldSpec {
+	var tagged []jsonmodel.FieldSpec
+	for _, f := range s.fields {
+		if len(g.segments(f, true)) > 0 {
+			tagged = append(tagged, f)
+		}
+	}
+	return tagged
+}
+
+func (g *generator) validateTaggedFields(s *structDef) error {
+	tags := make(map[int]string)
+	for _, f := range s.fields {
+		if f.Name == unknownTaggedFields {
+			return fmt.Errorf("field %s.%s conflicts with a generated field", s.name, f.Name)
+		}
+
+		if f.TaggedVersions.Empty() {
+			continue
+		}
+		if f.Tag == nil {
+			return fmt.Errorf("field %s.%s has tagged versions but no tag", s.name, f.Name)
+		}
+		if other, ok := tags[*f.Tag]; ok {
+			return fmt.Errorf("fields %s.%s and %s.%s have the same tag %d", s.name, other, s.name, f.Name, *f.Tag)
+		}
+		tags[*f.Tag] = f.Name
+	}
+	return nil
+}
+
+// writeEncodeTaggedFields emits the tagged field section of a struct. Known tagged fields are only
+// written when they differ from their default value.
+func (g *generator) writeEncodeTaggedFields(s *structDef) error {
+	if !g.isFlexible() {
+		return nil
+	}
+
+	flexible := g.flexibleVersions()
+	if cond := versionCondition(flexible.Lowest(), flexible.Highest(), g.spec.ValidVersions); cond != "" {
+		g.p("if %s {", cond)
+	} else {
+		g.p("{")
+	}
+
+	tagged := g.taggedFields(s)
+	if len(tagged) == 0 {
+		g.check("w.WriteTaggedFields(m.%s)", unknownTaggedFields)
+		g.p("}")
+		return nil
+	}
+
+	g.p("tagged := make([]protocol.TaggedField, 0, %d+len(m.%s))", len(tagged), unknownTaggedFields)
+	for _, f := range tagged {
+		nonDefault, err := g.defaultComparison(f, "m."+f.Name, false)
+		if err != nil {
+			return fmt.Errorf("field %s.%s: %w", s.name, f.Name, err)
+		}
+
+		err = g.writeVersioned(g.segments(f, true), flexible, func(mode fieldMode) error {
+			g.p("if %s {", nonDefault)
+			g.p("f, err := protocol.NewTaggedField(%d, func(w *protocol.MessageWriter) error {", *f.Tag)
+			if err := g.writeEncodeField(f, mode); err != nil {
+				return err
+			}
+			g.p("return nil")
+			g.p("})")
+			g.p("if err != nil {")
+			g.p("return err")
+			g.p("}")
+			g.p("tagged = append(tagged, f)")
+			g.p("}")
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("field %s.%s: %w", s.name, f.Name, err)
+		}
+	}
+	g.p("tagged = append(tagged, m.%s...)", unknownTaggedFields)
+	g.check("w.WriteTaggedFields(tagged)")
+	g.p("}")
+	return nil
+}
+
+// writeDecodeTaggedFields emits the decoding of the tagged field section of a struct. Tagged
+// fields that are not known in the decoded version are kept in UnknownTaggedFields so that they
+// are preserved when the struct is encoded again.
+func (g *generator) writeDecodeTaggedFields(s *structDef) error {
+	if !g.isFlexible() {
+		return nil
+	}
+
+	flexible := g.flexibleVersions()
+	if cond := versionCondition(flexible.Lowest(), flexible.Highest(), g.spec.ValidVersions); cond != "" {
+		g.p("if %s {", cond)
+	} else {
+		g.p("{")
+	}
+
+	g.p("tagged, err := r.ReadTaggedFields()")
+	g.p("if err != nil {")
+	g.p("return err")
+	g.p("}")
+	g.p("for _, f := range tagged {")
+	g.p("switch {")
+	for _, f := range g.taggedFields(s) {
+		for _, seg := range g.segments(f, true) {
+			cond := fmt.Sprintf("f.Tag == %d", *f.Tag)
+			if vc := versionCondition(seg.lowest, seg.highest, flexible); vc != "" {
+				cond += " && " + vc
+			}
+
+			g.p("case %s:", cond)
+			g.p("if err := func(r *protocol.MessageReader) (err error) {")
+			if err := g.writeDecodeField(f, seg.mode); err != nil {
+				return fmt.Errorf("field %s.%s: %w", s.name, f.Name, err)
+			}
+			g.p("return nil")
+			g.p("}(f.Reader()); err != nil {")
+			g.p("return err")
+			g.p("}")
+		}
+	}
+	g.p("default:")
+	g.p("m.%s = append(m.%s, f)", unknownTaggedFields, unknownTaggedFields)
+	g.p("}")
+	g.p("}")
+	g.p("}")
+	return nil
+}
+
+// defaultComparison returns an expression that compares the value of a field to its default
+// value, which is true if the value is the default when equal is set and true if the value
+// differs from the default otherwise.
+func (g *generator) defaultComparison(f jsonmodel.FieldSpec, expr string, equal bool) (string, error) {
+	op, join := "!=", " || "
+	if equal {
+		op, join = "==", " && "
+	}
+
+	t := parseFieldType(f.Type)
+	nullDefault := f.Default == "null"
+
+	switch {
+	case t.array, t.name == "bytes", t.name == "records":
+		if nullDefault {
+			return fmt.Sprintf("%s %s nil", expr, op), nil
+		}
+		return fmt.Sprintf("len(%s) %s 0", expr, op), nil
+	case t.name == "uuid":
+		g.imports[uuidImport] = true
+		return fmt.Sprintf("%s %s uuid.Nil", expr, op), nil
+	case t.isStruct():
+		if !f.NullableVersions.Empty() {
+			return fmt.Sprintf("%s %s nil", expr, op), nil
+		}
+		g.needsIsDefault[t.name] = true
+		if equal {
+			return fmt.Sprintf("%s.isDefault()", expr), nil
+		}
+		return fmt.Sprintf("!%s.isDefault()", expr), nil
+	}
+
+	lit, err := defaultLiteral(f)
+	if err != nil {
+		return "", err
+	}
+
+	switch t.name {
+	case "bool":
+		if (lit == "true") == equal {
+			return expr, nil
+		}
+		return "!" + expr, nil
+	case "string":
+		if f.NullableVersions.Empty() {
+			if lit == "" {
+				lit = `""`
+			}
+			return fmt.Sprintf("%s %s %s", expr, op, lit), nil
+		}
+		if lit == "" {
+			return fmt.Sprintf("%s %s nil", expr, op), nil
+		}
+		value := strings.TrimSpace(fmt.Sprint(f.Default))
+		if equal {
+			return fmt.Sprintf("%s != nil%s*%s == %q", expr, join, expr, value), nil
+		}
+		return fmt.Sprintf("%s == nil%s*%s != %q", expr, join, expr, value), nil
+	default:
+		if lit == "" {
+			lit = "0"
+		}
+		return fmt.Sprintf("%s %s %s", expr, op, lit), nil
+	}
+}
+
+// writeIsDefault emits isDefault, which reports whether every field of the struct has its default
+// value. It is used to omit tagged struct fields that have not been set.
+func (g *generator) writeIsDefault(s *structDef) error {
+	var conds []string
+	for _, f := range s.fields {
+		cond, err := g.defaultComparison(f, "m."+f.Name, true)
+		if err != nil {
+			return fmt.Errorf("field %s.%s: %w", s.name, f.Name, err)
+		}
+		conds = append(conds, cond)
+	}
+	if g.isFlexible() {
+		conds = append(conds, fmt.Sprintf("len(m.%s) == 0", unknownTaggedFields))
+	}
+	if len(conds) == 0 {
+		conds = append(conds, "true")
+	}
+
+	g.p("func (m *%s) isDefault() bool {", s.goName)
+	g.p("return %s", strings.Join(conds, " &&\n"))
+	g.p("}")
+	g.p("")
+	return nil
+}
@@ -0,0 +1,75 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethanmoffat/kafka-protocol/internal/jsonmodel"
+)
+
+type fieldType struct {
+	name  string // the primitive or struct type name, without any array prefix
+	array bool
+}
+
+const arrayPrefix = "[]"
+
+var primitiveGoTypes = map[string]string{
+	"bool":    "bool",
+	"int8":    "int8",
+	"int16":   "int16",
+	"uint16":  "uint16",
+	"int32":   "int32",
+	"uint32":  "uint32",
+	"int64":   "int64",
+	"float64": "float64",
+	"string":  "string",
+	"bytes":   "[]byte",
+	"uuid":    "uuid.UUID",
+	"records": "[]byte",
+}
+
+func parseFieldType(s string) fieldType {
+	if strings.HasPrefix(s, arrayPrefix) {
+		return fieldType{name: strings.TrimPrefix(s, arrayPrefix), array: true}
+	}
+	return fieldType{name: s}
+}
+
+func (t fieldType) isStruct() bool {
+	_, ok := primitiveGoTypes[t.name]
+	return !ok
+}
+
+func (g *generator) goType(f jsonmodel.FieldSpec) (string, error) {
+	t := parseFieldType(f.Type)
+
+	elem, err := g.elemGoType(t.name)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case t.array:
+		return arrayPrefix + elem, nil
+	case !f.NullableVersions.Empty() && (t.name == "string" || t.isStruct()):
+		return "*" + elem, nil
+	default:
+		return elem, nil
+	}
+}
+
+func (g *generator) elemGoType(name string) (string, error) {
+	if goType, ok := primitiveGoTypes[name]; ok {
+		if name == "uuid" {
+			g.imports[uuidImport] = true
+		}
+		return goType, nil
+	}
+
+	s, ok := g.byName[name]
+	if !ok {
+		return "", fmt.Errorf("unknown type %s", name)
+	}
+	return s.goName, nil
+}
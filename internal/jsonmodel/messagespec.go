@@ -1,6 +1,8 @@
 package jsonmodel
 
 import (
+	"encoding/json"
+
 	"github.com/ethanmoffat/kafka-protocol/internal/jsonmodel/entity"
 	"github.com/ethanmoffat/kafka-protocol/internal/jsonmodel/listener"
 	"github.com/ethanmoffat/kafka-protocol/internal/jsonmodel/message"
@@ -43,3 +45,44 @@ type StructSpec struct {
 	DeprecatedVersions versions.Range
 	Fields             []FieldSpec
 }
+
+// Version ranges that are omitted from a spec default to "none". Without these
+// the zero value of versions.Range would be read as version 0 only.
+
+func (m *MessageSpec) UnmarshalJSON(data []byte) error {
+	type messageSpec MessageSpec
+	spec := messageSpec{
+		DeprecatedVersions: *versions.None,
+		FlexibleVersions:   *versions.None,
+	}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return err
+	}
+	*m = MessageSpec(spec)
+	return nil
+}
+
+func (f *FieldSpec) UnmarshalJSON(data []byte) error {
+	type fieldSpec FieldSpec
+	spec := fieldSpec{
+		NullableVersions: *versions.None,
+		TaggedVersions:   *versions.None,
+	}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return err
+	}
+	*f = FieldSpec(spec)
+	return nil
+}
+
+func (s *StructSpec) UnmarshalJSON(data []byte) error {
+	type structSpec StructSpec
+	spec := structSpec{
+		DeprecatedVersions: *versions.None,
+	}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return err
+	}
+	*s = StructSpec(spec)
+	return nil
+}
@@ -55,7 +55,7 @@ func Parse(s string, defaultVersions *Range) (*Range, error) {
 			if v, err := strconv.ParseInt(trimmed, 10, 16); err != nil {
 				return nil, err
 			} else {
-				return New(int(v), math.MaxInt16)
+				return New(int(v), int(v))
 			}
 		}
 
@@ -85,6 +85,24 @@ func (v Range) Empty() bool {
 	return v.highest < v.lowest
 }
 
+func (v Range) Contains(version int) bool {
+	return version >= v.lowest && version <= v.highest
+}
+
+func (v Range) Intersect(other Range) Range {
+	lowest, highest := v.lowest, v.highest
+	if other.lowest > lowest {
+		lowest = other.lowest
+	}
+	if other.highest < highest {
+		highest = other.highest
+	}
+	if highest < lowest {
+		return *None
+	}
+	return Range{lowest, highest}
+}
+
 func (v Range) String() string {
 	if v.Empty() {
 		return NoneString
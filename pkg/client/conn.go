@@ -0,0 +1,353 @@
+// Package client implements connections to Kafka brokers.
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+	"github.com/ethanmoffat/kafka-protocol/pkg/sasl"
+)
+
+var (
+	ErrClosed              = errors.New("connection closed")
+	ErrCorrelationMismatch = errors.New("response does not match an in-flight request")
+)
+
+// Conn is a connection to a broker. Any number of goroutines may send requests concurrently; up to
+// the configured maximum are in flight at once, and responses are matched to their requests by
+// correlation id.
+type Conn struct {
+	conn     net.Conn
+	options  options
+	reader   *protocol.FrameReader
+	writer   *protocol.FrameWriter
+	inFlight chan struct{}
+
+	writeMu sync.Mutex
+
+	negotiateMu    sync.Mutex
+	brokerVersions map[protocol.ApiKey]VersionRange
+
+	// authMu is held for reading by requests and for writing while the connection authenticates,
+	// so that no other request is sent during a SASL exchange.
+	authMu    sync.RWMutex
+	mechanism sasl.Mechanism
+	// reauthAt is when the connection re-authenticates, or zero if its session does not expire.
+	reauthAt time.Time
+
+	mu            sync.Mutex
+	correlationId int32
+	pending       map[int32]chan response
+	err           error
+	done          chan struct{}
+
+	// raw receives the next frame instead of pending while a SASL token without a request header
+	// is in flight.
+	raw chan response
+}
+
+type response struct {
+	frame []byte
+	err   error
+}
+
+// Dial connects to the broker at address over TCP, or TLS if it is configured, negotiates API
+// versions and, if a SASL mechanism is configured, authenticates.
+func Dial(address string, opts ...Option) (*Conn, error) {
+	return DialContext(context.Background(), address, opts...)
+}
+
+// DialContext connects to the broker at address over TCP, or TLS if it is configured, negotiates
+// API versions and, if a SASL mechanism is configured, authenticates.
+func DialContext(ctx context.Context, address string, opts ...Option) (*Conn, error) {
+	o := newOptions(opts)
+	var conn net.Conn
+	var err error
+	if o.tls != nil {
+		// the TLS handshake completes before the connection is returned, so that certificate
+		// errors are reported by Dial
+		dialer := &tls.Dialer{NetDialer: o.dialer, Config: o.tls}
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	} else {
+		conn, err = o.dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c := newConn(conn, o)
+	if err := c.Negotiate(ctx); err != nil {
+		c.Close()
+		return nil, err
+	}
+	if o.mechanism != nil {
+		if err := c.Authenticate(ctx, o.mechanism); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// NewConn returns a Conn that talks to a broker over an established connection, such as a
+// *tls.Conn. API versions are negotiated by the first call to RoundTrip.
+func NewConn(conn net.Conn, opts ...Option) *Conn {
+	return newConn(conn, newOptions(opts))
+}
+
+func newConn(conn net.Conn, o options) *Conn {
+	c := &Conn{
+		conn:     conn,
+		options:  o,
+		reader:   protocol.NewFrameReader(conn, o.maxFrameSize),
+		writer:   protocol.NewFrameWriter(conn),
+		inFlight: make(chan struct{}, o.maxInFlight),
+		pending:  make(map[int32]chan response),
+		done:     make(chan struct{}),
+	}
+	go c.readLoop()
+	return c
+}
+
+// RoundTrip sends req with the next correlation id of the connection and returns the response
+// from the broker. The version of req is set to the highest version supported by both the broker
+// and this library, unless negotiation is disabled, and the response has the same version as req.
+// Produce requests with acks set to 0 get no response from the broker, so RoundTrip returns a nil
+// response for them.
+//
+// If ctx is done before the response arrives, RoundTrip returns the error of ctx. The request may
+// still have been sent, and its response is discarded when it arrives.
+//
+// If the SASL session of the connection is about to expire, the connection re-authenticates before
+// req is sent.
+func (c *Conn) RoundTrip(ctx context.Context, req protocol.Message) (protocol.Message, error) {
+	if c.options.negotiate {
+		if err := c.Negotiate(ctx); err != nil {
+			return nil, err
+		}
+		version, err := c.ApiVersion(req.ApiKey())
+		if err != nil {
+			return nil, err
+		}
+		req.SetVersion(version)
+	}
+	if err := c.reauthenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	return c.roundTrip(ctx, req)
+}
+
+func (c *Conn) roundTrip(ctx context.Context, req protocol.Message) (protocol.Message, error) {
+	api, ok := protocol.Lookup(req.ApiKey())
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", protocol.ErrUnknownApiKey, req.ApiKey())
+	}
+
+	frame, err := c.send(ctx, req)
+	if err != nil || frame == nil {
+		return nil, err
+	}
+	res := api.NewResponse(req.Version())
+	if err := messages.DecodeResponse(protocol.NewMessageReader(bytes.NewReader(frame)), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// send sends req and returns the frame of its response, or nil if the broker does not answer req.
+func (c *Conn) send(ctx context.Context, req protocol.Message) ([]byte, error) {
+	// the body does not depend on the correlation id, so it is encoded first. A request that
+	// cannot be encoded fails on its own, without failing the connection.
+	var body bytes.Buffer
+	if err := req.Encode(protocol.NewMessageWriter(&body)); err != nil {
+		return nil, err
+	}
+
+	select {
+	case c.inFlight <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.done:
+		return nil, c.failure()
+	}
+
+	wait := expectsResponse(req)
+	ch, err := c.register(req, wait)
+	if err != nil {
+		<-c.inFlight
+		return nil, err
+	}
+
+	var header bytes.Buffer
+	if err := messages.RequestHeaderFor(req, c.options.clientId).Encode(protocol.NewMessageWriter(&header)); err != nil {
+		c.unregister(int32(req.CorrelationId()))
+		<-c.inFlight
+		return nil, err
+	}
+
+	if err := c.write(header.Bytes(), body.Bytes()); err != nil {
+		c.fail(err)
+		if !wait {
+			<-c.inFlight
+		}
+		return nil, err
+	}
+	if !wait {
+		<-c.inFlight
+		return nil, nil
+	}
+
+	select {
+	case res := <-ch:
+		return res.frame, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// register assigns the next correlation id to req and, if wait is set, returns the channel that
+// receives its response.
+func (c *Conn) register(req protocol.Message, wait bool) (chan response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	c.correlationId++
+	req.SetCorrelationId(int(c.correlationId))
+	if !wait {
+		return nil, nil
+	}
+
+	// the channel is buffered so that the reader never blocks on a request that was abandoned
+	ch := make(chan response, 1)
+	c.pending[c.correlationId] = ch
+	return ch, nil
+}
+
+// unregister forgets the request with a correlation id, which will not be sent.
+func (c *Conn) unregister(id int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.pending, id)
+}
+
+func (c *Conn) write(header, body []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.writer.WriteFrame(header, body)
+}
+
+// readLoop routes response frames to their requests until the connection fails.
+func (c *Conn) readLoop() {
+	for {
+		frame, err := c.reader.ReadFrame()
+		if err != nil {
+			c.fail(err)
+			return
+		}
+
+		c.mu.Lock()
+		raw := c.raw
+		c.raw = nil
+		c.mu.Unlock()
+		if raw != nil {
+			raw <- response{frame: frame}
+			<-c.inFlight
+			continue
+		}
+
+		if len(frame) < 4 {
+			c.fail(io.ErrUnexpectedEOF)
+			return
+		}
+
+		// the correlation id is the first field of every response header version
+		id := int32(binary.BigEndian.Uint32(frame))
+		c.mu.Lock()
+		ch, ok := c.pending[id]
+		delete(c.pending, id)
+		c.mu.Unlock()
+		if !ok {
+			c.fail(fmt.Errorf("%w: correlation id %d", ErrCorrelationMismatch, id))
+			return
+		}
+
+		ch <- response{frame: frame}
+		<-c.inFlight
+	}
+}
+
+// fail closes the connection and fails every in-flight request with err. Only the first failure
+// is recorded.
+func (c *Conn) fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return
+	}
+
+	c.err = err
+	c.conn.Close()
+	for id, ch := range c.pending {
+		ch <- response{err: err}
+		delete(c.pending, id)
+	}
+	if c.raw != nil {
+		c.raw <- response{err: err}
+		c.raw = nil
+	}
+	close(c.done)
+}
+
+func (c *Conn) failure() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// Close closes the connection. In-flight requests fail with ErrClosed.
+func (c *Conn) Close() error {
+	c.fail(ErrClosed)
+	return nil
+}
+
+// Done returns a channel that is closed when the connection has failed or been closed.
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err returns the error that the connection failed with, or nil if it has not failed.
+func (c *Conn) Err() error {
+	return c.failure()
+}
+
+func (c *Conn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+func (c *Conn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+// expectsResponse reports whether the broker answers req.
+func expectsResponse(req protocol.Message) bool {
+	if produce, ok := req.(*messages.ProduceRequest); ok {
+		return produce.Acks != 0
+	}
+	return true
+}
@@ -0,0 +1,323 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+)
+
+// fakeBroker is the broker end of a net.Pipe. Tests read requests from it and write responses in
+// whatever order they need.
+type fakeBroker struct {
+	t      *testing.T
+	conn   net.Conn
+	reader *protocol.FrameReader
+	writer *protocol.FrameWriter
+}
+
+// newPipe returns a Conn connected to a fakeBroker.
+func newPipe(t *testing.T, opts ...Option) (*Conn, *fakeBroker) {
+	t.Helper()
+	client, server := net.Pipe()
+	c := NewConn(client, opts...)
+	t.Cleanup(func() {
+		c.Close()
+		server.Close()
+	})
+	return c, &fakeBroker{
+		t:      t,
+		conn:   server,
+		reader: protocol.NewFrameReader(server, protocol.DefaultMaxFrameSize),
+		writer: protocol.NewFrameWriter(server),
+	}
+}
+
+// read reads the next request.
+func (b *fakeBroker) read() protocol.Message {
+	b.t.Helper()
+	frame, err := b.reader.ReadFrame()
+	if err != nil {
+		b.t.Fatalf("reading request: %v", err)
+	}
+	_, req, err := messages.DecodeRequest(frame)
+	if err != nil {
+		b.t.Fatalf("decoding request: %v", err)
+	}
+	return req
+}
+
+// write writes res with the correlation id of req.
+func (b *fakeBroker) write(req, res protocol.Message) {
+	b.t.Helper()
+	res.SetVersion(req.Version())
+	res.SetCorrelationId(req.CorrelationId())
+	var buf bytes.Buffer
+	if err := messages.EncodeResponse(protocol.NewMessageWriter(&buf), res); err != nil {
+		b.t.Fatal(err)
+	}
+	if err := b.writer.WriteFrame(buf.Bytes(), nil); err != nil {
+		b.t.Fatalf("writing response: %v", err)
+	}
+}
+
+// answer answers a FindCoordinator request with a coordinator whose host is the key of the
+// request, which lets tests check that responses reach the right request.
+func (b *fakeBroker) answer(req protocol.Message) {
+	b.t.Helper()
+	res := messages.NewFindCoordinatorResponse(0)
+	res.Host = req.(*messages.FindCoordinatorRequest).Key
+	res.Port = 9092
+	b.write(req, res)
+}
+
+func findCoordinator(key string) *messages.FindCoordinatorRequest {
+	req := messages.NewFindCoordinatorRequest(0)
+	req.Key = key
+	return req
+}
+
+type result struct {
+	key string
+	res protocol.Message
+	err error
+}
+
+// roundTrips sends a FindCoordinator request for each key from its own goroutine, and returns the
+// channel that receives their results.
+func roundTrips(c *Conn, keys ...string) <-chan result {
+	results := make(chan result, len(keys))
+	for _, key := range keys {
+		go func() {
+			res, err := c.RoundTrip(context.Background(), findCoordinator(key))
+			results <- result{key: key, res: res, err: err}
+		}()
+	}
+	return results
+}
+
+func TestRoundTrip(t *testing.T) {
+	c, b := newPipe(t, WithClientId("test"))
+
+	results := roundTrips(c, "group")
+	req := b.read().(*messages.ApiVersionsRequest)
+	if req.Version() != 3 || req.ClientSoftwareName != "kafka-protocol" {
+		t.Errorf("ApiVersions request %+v", req)
+	}
+	versions := messages.NewApiVersionsResponse(3)
+	versions.ApiKeys = []messages.ApiVersionsResponseApiVersion{
+		{ApiKey: int16(protocol.ApiVersions), MinVersion: 0, MaxVersion: 3},
+		{ApiKey: int16(protocol.FindCoordinator), MinVersion: 0, MaxVersion: 2},
+	}
+	b.write(req, versions)
+	b.answer(b.read())
+
+	r := <-results
+	if r.err != nil {
+		t.Fatal(r.err)
+	}
+	res := r.res.(*messages.FindCoordinatorResponse)
+	if res.Version() != 2 || res.Host != "group" || res.Port != 9092 {
+		t.Errorf("response %+v, want v2 for group", res)
+	}
+	if got := c.BrokerVersions()[protocol.FindCoordinator]; got != (VersionRange{Min: 0, Max: 2}) {
+		t.Errorf("broker versions of FindCoordinator = %v", got)
+	}
+}
+
+func TestCorrelation(t *testing.T) {
+	c, b := newPipe(t, WithoutNegotiation())
+
+	keys := []string{"a", "b", "c"}
+	results := roundTrips(c, keys...)
+	var requests []protocol.Message
+	for range keys {
+		requests = append(requests, b.read())
+	}
+	// the responses arrive in the opposite order of the requests
+	for i := len(requests) - 1; i >= 0; i-- {
+		b.answer(requests[i])
+	}
+
+	seen := make(map[int]bool)
+	for range keys {
+		r := <-results
+		if r.err != nil {
+			t.Fatal(r.err)
+		}
+		res := r.res.(*messages.FindCoordinatorResponse)
+		if res.Host != r.key {
+			t.Errorf("request for %s got the response for %s", r.key, res.Host)
+		}
+		if seen[res.CorrelationId()] {
+			t.Errorf("correlation id %d used twice", res.CorrelationId())
+		}
+		seen[res.CorrelationId()] = true
+	}
+}
+
+func TestCorrelationMismatch(t *testing.T) {
+	c, b := newPipe(t, WithoutNegotiation())
+
+	results := roundTrips(c, "a", "b")
+	req := b.read()
+	b.read()
+	unknown := findCoordinator("a")
+	unknown.SetCorrelationId(req.CorrelationId() + 100)
+	b.answer(unknown)
+
+	// both requests fail with the connection
+	for range 2 {
+		if r := <-results; !errors.Is(r.err, ErrCorrelationMismatch) {
+			t.Errorf("request for %s: error = %v, want ErrCorrelationMismatch", r.key, r.err)
+		}
+	}
+	<-c.Done()
+	if !errors.Is(c.Err(), ErrCorrelationMismatch) {
+		t.Errorf("Err() = %v, want ErrCorrelationMismatch", c.Err())
+	}
+}
+
+func TestMaxInFlight(t *testing.T) {
+	c, b := newPipe(t, WithoutNegotiation(), WithMaxInFlight(2))
+
+	results := roundTrips(c, "a", "b", "c")
+	first, second := b.read(), b.read()
+
+	// the third request waits for a response before it is written
+	b.conn.SetReadDeadline(time.Now().Add(50 * time.Millisecond))
+	if _, err := b.reader.ReadFrame(); !isTimeout(err) {
+		t.Fatalf("read a third request while two were in flight: %v", err)
+	}
+	b.conn.SetReadDeadline(time.Time{})
+
+	b.answer(first)
+	third := b.read()
+	b.answer(second)
+	b.answer(third)
+	for range 3 {
+		if r := <-results; r.err != nil {
+			t.Error(r.err)
+		}
+	}
+}
+
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func TestFailure(t *testing.T) {
+	c, b := newPipe(t, WithoutNegotiation())
+
+	results := roundTrips(c, "a", "b")
+	b.read()
+	b.read()
+	b.conn.Close()
+
+	for range 2 {
+		if r := <-results; r.err == nil {
+			t.Errorf("request for %s succeeded on a closed connection", r.key)
+		}
+	}
+	<-c.Done()
+	if c.Err() == nil {
+		t.Error("Err() = nil after the broker closed the connection")
+	}
+	if _, err := c.RoundTrip(context.Background(), findCoordinator("c")); !errors.Is(err, c.Err()) {
+		t.Errorf("RoundTrip() error = %v, want %v", err, c.Err())
+	}
+}
+
+func TestClose(t *testing.T) {
+	c, b := newPipe(t, WithoutNegotiation())
+
+	results := roundTrips(c, "a")
+	b.read()
+	c.Close()
+	if r := <-results; !errors.Is(r.err, ErrClosed) {
+		t.Errorf("error = %v, want ErrClosed", r.err)
+	}
+	if _, err := c.RoundTrip(context.Background(), findCoordinator("b")); !errors.Is(err, ErrClosed) {
+		t.Errorf("RoundTrip() error = %v, want ErrClosed", err)
+	}
+}
+
+func TestContextCancel(t *testing.T) {
+	c, b := newPipe(t, WithoutNegotiation())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		_, err := c.RoundTrip(ctx, findCoordinator("a"))
+		errs <- err
+	}()
+	abandoned := b.read()
+	cancel()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want context.Canceled", err)
+	}
+
+	// the response to the abandoned request is discarded, and the connection still works
+	b.answer(abandoned)
+	results := roundTrips(c, "b")
+	b.answer(b.read())
+	if r := <-results; r.err != nil || r.res.(*messages.FindCoordinatorResponse).Host != "b" {
+		t.Errorf("result %+v after an abandoned request", r)
+	}
+}
+
+func TestProduceWithoutAcks(t *testing.T) {
+	c, b := newPipe(t, WithoutNegotiation())
+
+	type result struct {
+		res protocol.Message
+		err error
+	}
+	results := make(chan result, 1)
+	go func() {
+		req := messages.NewProduceRequest(3)
+		req.Acks = 0
+		res, err := c.RoundTrip(context.Background(), req)
+		results <- result{res, err}
+	}()
+	if req := b.read().(*messages.ProduceRequest); req.Acks != 0 {
+		t.Errorf("Acks = %d", req.Acks)
+	}
+	if r := <-results; r.res != nil || r.err != nil {
+		t.Errorf("RoundTrip() = %v, %v, want no response", r.res, r.err)
+	}
+}
+
+func TestEncodeError(t *testing.T) {
+	// a key too long for a string
+	long := string(make([]byte, 1<<15))
+	c, b := newPipe(t, WithoutNegotiation(), WithMaxInFlight(1))
+	if _, err := c.RoundTrip(context.Background(), findCoordinator(long)); err == nil {
+		t.Fatal("sent a request that cannot be encoded")
+	}
+
+	// the connection is still usable, and the request released its in-flight slot
+	results := roundTrips(c, "a")
+	b.answer(b.read())
+	if r := <-results; r.err != nil {
+		t.Error(r.err)
+	}
+
+	// a client id too long for the request header fails after the request is registered
+	c, _ = newPipe(t, WithoutNegotiation(), WithClientId(long))
+	if _, err := c.RoundTrip(context.Background(), findCoordinator("a")); err == nil {
+		t.Fatal("sent a request whose header cannot be encoded")
+	}
+	c.mu.Lock()
+	pending := len(c.pending)
+	c.mu.Unlock()
+	if c.Err() != nil || pending != 0 || len(c.inFlight) != 0 {
+		t.Errorf("after a header error: Err() = %v, %d pending, %d in flight", c.Err(), pending, len(c.inFlight))
+	}
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"crypto/tls"
+	"net"
+	"time"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/sasl"
+)
+
+// DefaultMaxInFlight is the default maximum number of in-flight requests per connection, which
+// matches max.in.flight.requests.per.connection of the Java client.
+const DefaultMaxInFlight = 5
+
+// Option configures a Conn.
+type Option func(*options)
+
+type options struct {
+	clientId     *string
+	maxFrameSize int
+	maxInFlight  int
+	dialer       *net.Dialer
+	tls          *tls.Config
+
+	negotiate       bool
+	softwareName    string
+	softwareVersion string
+
+	mechanism sasl.Mechanism
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		maxFrameSize: protocol.DefaultMaxFrameSize,
+		maxInFlight:  DefaultMaxInFlight,
+		dialer:       &net.Dialer{Timeout: 30 * time.Second},
+
+		negotiate:       true,
+		softwareName:    "kafka-protocol",
+		softwareVersion: "unknown",
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithClientId sets the client id sent in the header of every request.
+func WithClientId(clientId string) Option {
+	return func(o *options) {
+		o.clientId = &clientId
+	}
+}
+
+// WithMaxFrameSize sets the largest response frame that is accepted.
+func WithMaxFrameSize(size int) Option {
+	return func(o *options) {
+		o.maxFrameSize = size
+	}
+}
+
+// WithDialer sets the dialer used by Dial.
+func WithDialer(dialer *net.Dialer) Option {
+	return func(o *options) {
+		o.dialer = dialer
+	}
+}
+
+// WithTLS makes Dial connect over TLS with config. The server name is taken from the dialed address
+// unless config sets one. For mutual TLS, config holds the client certificate in Certificates or
+// GetClientCertificate.
+func WithTLS(config *tls.Config) Option {
+	return func(o *options) {
+		o.tls = config
+	}
+}
+
+// WithMaxInFlight sets the maximum number of requests that are in flight on the connection at
+// once. Further requests wait for a response before they are sent.
+func WithMaxInFlight(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.maxInFlight = n
+		}
+	}
+}
+
+// WithoutNegotiation disables ApiVersions negotiation. Requests are sent with the version they were
+// created with.
+func WithoutNegotiation() Option {
+	return func(o *options) {
+		o.negotiate = false
+	}
+}
+
+// WithClientSoftware sets the client software name and version sent in ApiVersions requests. The
+// broker only accepts letters, digits, '-' and '.', starting and ending with a letter or digit.
+func WithClientSoftware(name, version string) Option {
+	return func(o *options) {
+		o.softwareName = name
+		o.softwareVersion = version
+	}
+}
+
+// WithSasl makes Dial authenticate the connection with a SASL mechanism after negotiating API
+// versions.
+func WithSasl(mechanism sasl.Mechanism) Option {
+	return func(o *options) {
+		o.mechanism = mechanism
+	}
+}
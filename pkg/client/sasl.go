@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+	"github.com/ethanmoffat/kafka-protocol/pkg/sasl"
+)
+
+// Authenticate authenticates the connection with a SASL mechanism. Brokers that support
+// SaslHandshake v1 exchange the tokens of the mechanism in SaslAuthenticate requests; older
+// brokers take them as raw frames without a request header after a v0 handshake. It must be
+// called before any other request is sent, other than ApiVersions.
+//
+// A broker that rejects the mechanism or the credentials fails with the error code of its
+// response, such as protocol.ErrUnsupportedSaslMechanism or protocol.ErrSaslAuthenticationFailed.
+// After a v0 handshake the broker closes the connection instead, which also fails with
+// protocol.ErrSaslAuthenticationFailed.
+//
+// Brokers that limit the lifetime of sessions (KIP-368) close connections that do not
+// re-authenticate before their session expires. RoundTrip re-authenticates with the same
+// mechanism once 85-95% of the lifetime has passed, as the Java client does.
+func (c *Conn) Authenticate(ctx context.Context, mechanism sasl.Mechanism) error {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	return c.authenticate(ctx, mechanism)
+}
+
+// reauthenticate authenticates the connection again if its session is about to expire. The
+// connection fails if re-authentication fails, as the broker closes it once the session expires.
+func (c *Conn) reauthenticate(ctx context.Context) error {
+	c.authMu.RLock()
+	due := !c.reauthAt.IsZero() && !time.Now().Before(c.reauthAt)
+	c.authMu.RUnlock()
+	if !due {
+		return nil
+	}
+
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	// another request may have re-authenticated while the lock was released
+	if c.reauthAt.IsZero() || time.Now().Before(c.reauthAt) {
+		return nil
+	}
+	if err := c.authenticate(ctx, c.mechanism); err != nil {
+		err = fmt.Errorf("re-authentication failed: %w", err)
+		c.fail(err)
+		return err
+	}
+	return nil
+}
+
+func (c *Conn) authenticate(ctx context.Context, mechanism sasl.Mechanism) error {
+	if err := c.Negotiate(ctx); err != nil {
+		return err
+	}
+	version, err := c.apiVersion(protocol.SaslHandshake, 1)
+	if err != nil {
+		return err
+	}
+
+	handshake := messages.NewSaslHandshakeRequest(version)
+	handshake.Mechanism = mechanism.Name()
+	res, err := c.roundTrip(ctx, handshake)
+	if err != nil {
+		return err
+	}
+	mechanisms := res.(*messages.SaslHandshakeResponse)
+	if code := protocol.ErrorCode(mechanisms.ErrorCode); code != protocol.NoError {
+		return fmt.Errorf("%w: broker enables %s", code, strings.Join(mechanisms.Mechanisms, ", "))
+	}
+
+	// the lifetime of the session counts from before the exchange, as it does on the broker
+	start := time.Now()
+	session, token, err := mechanism.Start(ctx)
+	if err != nil {
+		return err
+	}
+	var lifetime time.Duration
+	for {
+		var challenge []byte
+		if version == 0 {
+			challenge, err = c.sendRaw(ctx, token)
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				// brokers close the connection instead of answering a failed exchange after a v0
+				// handshake
+				err = fmt.Errorf("%w: connection closed during authentication", protocol.ErrSaslAuthenticationFailed)
+			}
+		} else {
+			challenge, lifetime, err = c.saslAuthenticate(ctx, token)
+		}
+		if err != nil {
+			return err
+		}
+
+		done, response, err := session.Next(ctx, challenge)
+		if err != nil {
+			return err
+		}
+		if done {
+			break
+		}
+		token = response
+	}
+
+	c.mechanism = mechanism
+	c.reauthAt = time.Time{}
+	if lifetime > 0 {
+		c.reauthAt = start.Add(time.Duration(float64(lifetime) * (0.85 + 0.1*rand.Float64())))
+	}
+	return nil
+}
+
+// saslAuthenticate sends a SASL token in a SaslAuthenticate request and returns the token of the
+// response, and the lifetime of the session if the broker limits it.
+func (c *Conn) saslAuthenticate(ctx context.Context, token []byte) ([]byte, time.Duration, error) {
+	version, err := c.apiVersion(protocol.SaslAuthenticate, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	req := messages.NewSaslAuthenticateRequest(version)
+	req.AuthBytes = token
+	res, err := c.roundTrip(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+	authenticated := res.(*messages.SaslAuthenticateResponse)
+	if code := protocol.ErrorCode(authenticated.ErrorCode); code != protocol.NoError {
+		if authenticated.ErrorMessage != nil {
+			return nil, 0, fmt.Errorf("%w: %s", code, *authenticated.ErrorMessage)
+		}
+		return nil, 0, code
+	}
+	return authenticated.AuthBytes, time.Duration(authenticated.SessionLifetimeMs) * time.Millisecond, nil
+}
+
+// sendRaw sends a SASL token in a frame without a request header, as brokers expect after a v0
+// SaslHandshake, and returns the frame that answers it. The connection fails if ctx is done
+// before the answer arrives, as the exchange cannot be resumed.
+func (c *Conn) sendRaw(ctx context.Context, token []byte) ([]byte, error) {
+	select {
+	case c.inFlight <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.done:
+		return nil, c.failure()
+	}
+
+	ch := make(chan response, 1)
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		<-c.inFlight
+		return nil, c.err
+	}
+	c.raw = ch
+	c.mu.Unlock()
+
+	c.writeMu.Lock()
+	err := c.writer.WriteFrame(nil, token)
+	c.writeMu.Unlock()
+	if err != nil {
+		c.fail(err)
+		return nil, err
+	}
+
+	select {
+	case res := <-ch:
+		return res.frame, res.err
+	case <-ctx.Done():
+		c.fail(ctx.Err())
+		return nil, ctx.Err()
+	}
+}
+
+// apiVersion returns the version to send requests of an API with: the highest version supported
+// by both the broker and this library, or version if negotiation is disabled.
+func (c *Conn) apiVersion(key protocol.ApiKey, version int) (int, error) {
+	if !c.options.negotiate {
+		return version, nil
+	}
+	return c.ApiVersion(key)
+}
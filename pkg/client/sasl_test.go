@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/kafkatest"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+	"github.com/ethanmoffat/kafka-protocol/pkg/sasl"
+)
+
+func newBroker(t *testing.T, opts ...kafkatest.Option) *kafkatest.Broker {
+	t.Helper()
+	b, err := kafkatest.NewBroker(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { b.Close() })
+	return b
+}
+
+func dial(t *testing.T, address string, opts ...Option) (*Conn, error) {
+	t.Helper()
+	c, err := Dial(address, opts...)
+	if err == nil {
+		t.Cleanup(func() { c.Close() })
+	}
+	return c, err
+}
+
+// metadata sends a Metadata request, which brokers only answer once the connection has
+// authenticated.
+func metadata(c *Conn) error {
+	_, err := c.RoundTrip(context.Background(), messages.NewMetadataRequest(0))
+	return err
+}
+
+var plainServer = sasl.PlainServer{
+	Authenticate: func(_ context.Context, username, password string) error {
+		if username != "alice" || password != "secret" {
+			return errors.New("wrong password")
+		}
+		return nil
+	},
+}
+
+// TestPlain authenticates with PLAIN after a v0 handshake, where the tokens are sent without a
+// request header, and after a v1 handshake, where they are sent in SaslAuthenticate requests.
+func TestPlain(t *testing.T) {
+	for _, version := range []int{0, 1} {
+		t.Run(fmt.Sprintf("v%d", version), func(t *testing.T) {
+			b := newBroker(t,
+				kafkatest.WithSaslMechanisms(plainServer),
+				kafkatest.WithVersions(protocol.SaslHandshake, version, version))
+
+			c, err := dial(t, b.Addr(), WithSasl(sasl.Plain{Username: "alice", Password: "secret"}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v, _ := c.ApiVersion(protocol.SaslHandshake); v != version {
+				t.Errorf("SaslHandshake v%d, want v%d", v, version)
+			}
+			if err := metadata(c); err != nil {
+				t.Errorf("request after authenticating: %v", err)
+			}
+
+			_, err = dial(t, b.Addr(), WithSasl(sasl.Plain{Username: "alice", Password: "guess"}))
+			if !errors.Is(err, protocol.ErrSaslAuthenticationFailed) {
+				t.Errorf("wrong password: error = %v, want ErrSaslAuthenticationFailed", err)
+			}
+
+			_, err = dial(t, b.Addr(), WithSasl(sasl.Plain{Authzid: "bob", Username: "alice", Password: "secret"}))
+			if !errors.Is(err, protocol.ErrSaslAuthenticationFailed) {
+				t.Errorf("other authzid: error = %v, want ErrSaslAuthenticationFailed", err)
+			}
+
+			c, err = dial(t, b.Addr())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := metadata(c); err == nil {
+				t.Error("broker answered a request before authentication")
+			}
+		})
+	}
+}
+
+func TestUnsupportedMechanism(t *testing.T) {
+	b := newBroker(t, kafkatest.WithScram())
+
+	_, err := dial(t, b.Addr(), WithSasl(sasl.Plain{Username: "alice", Password: "secret"}))
+	if !errors.Is(err, protocol.ErrUnsupportedSaslMechanism) {
+		t.Errorf("error = %v, want ErrUnsupportedSaslMechanism", err)
+	}
+}
+
+// jwt is a fixed unsigned JWT, as issued by a stand-in token endpoint.
+const jwt = "eyJhbGciOiJub25lIn0.eyJzdWIiOiJhbGljZSJ9."
+
+// TestReauthentication checks that a connection re-authenticates within 85-95% of the session
+// lifetime that the broker sends in SaslAuthenticate responses (KIP-368), before the broker
+// closes it.
+func TestReauthentication(t *testing.T) {
+	const lifetime = 400 * time.Millisecond
+	var mu sync.Mutex
+	validated := 0
+	b := newBroker(t,
+		kafkatest.WithSessionLifetime(lifetime),
+		kafkatest.WithSaslMechanisms(sasl.OAuthBearerServer{
+			Validate: func(_ context.Context, token string, _ map[string]string) (string, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				validated++
+				if token != jwt {
+					return "", errors.New("unknown token")
+				}
+				return "alice", nil
+			},
+		}))
+
+	tokens := 0
+	mechanism := sasl.OAuthBearer{
+		TokenProvider: func(context.Context) (sasl.OAuthBearerToken, error) {
+			tokens++
+			return sasl.OAuthBearerToken{Value: jwt}, nil
+		},
+	}
+	start := time.Now()
+	c, err := dial(t, b.Addr(), WithSasl(mechanism))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.authMu.RLock()
+	reauthAt := c.reauthAt
+	c.authMu.RUnlock()
+	if earliest, latest := start.Add(lifetime*85/100), time.Now().Add(lifetime*95/100); reauthAt.Before(earliest) || reauthAt.After(latest) {
+		t.Errorf("re-authenticates %v after dialing, want 85-95%% of %v", reauthAt.Sub(start), lifetime)
+	}
+
+	// requests keep working while the connection re-authenticates twice
+	for deadline := start.Add(4 * lifetime); tokens < 3; time.Sleep(lifetime / 10) {
+		if err := metadata(c); err != nil {
+			t.Fatalf("request after %v: %v", time.Since(start), err)
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d tokens after %v, want 3", tokens, time.Since(start))
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if validated != tokens {
+		t.Errorf("broker validated %d of %d tokens", validated, tokens)
+	}
+}
+
+func TestOAuthBearerRejected(t *testing.T) {
+	b := newBroker(t, kafkatest.WithSaslMechanisms(sasl.OAuthBearerServer{
+		Validate: func(context.Context, string, map[string]string) (string, error) {
+			return "", errors.New("token expired")
+		},
+	}))
+
+	mechanism := sasl.OAuthBearer{
+		TokenProvider: func(context.Context) (sasl.OAuthBearerToken, error) {
+			return sasl.OAuthBearerToken{Value: jwt}, nil
+		},
+	}
+	_, err := dial(t, b.Addr(), WithSasl(mechanism))
+	if !errors.Is(err, protocol.ErrSaslAuthenticationFailed) {
+		t.Errorf("error = %v, want ErrSaslAuthenticationFailed", err)
+	}
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"crypto/tls"
+	"errors"
+	"testing"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/kafkatest"
+)
+
+func newCertificateAuthority(t *testing.T) *kafkatest.CertificateAuthority {
+	t.Helper()
+	ca, err := kafkatest.NewCertificateAuthority()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ca
+}
+
+func issue(t *testing.T, ca *kafkatest.CertificateAuthority, hosts ...string) tls.Certificate {
+	t.Helper()
+	cert, err := ca.Issue(hosts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestTLS(t *testing.T) {
+	ca := newCertificateAuthority(t)
+	b := newBroker(t, kafkatest.WithTLS(&tls.Config{
+		Certificates: []tls.Certificate{issue(t, ca, "localhost", "127.0.0.1")},
+	}))
+
+	c, err := dial(t, b.Addr(), WithTLS(&tls.Config{RootCAs: ca.Pool()}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.conn.(*tls.Conn); !ok {
+		t.Errorf("connection is a %T, want *tls.Conn", c.conn)
+	}
+	if err := metadata(c); err != nil {
+		t.Error(err)
+	}
+
+	// without TLS the broker cannot read the request
+	if _, err := dial(t, b.Addr()); err == nil {
+		t.Error("connected to a TLS broker without TLS")
+	}
+}
+
+func TestMutualTLS(t *testing.T) {
+	ca := newCertificateAuthority(t)
+	b := newBroker(t, kafkatest.WithTLS(&tls.Config{
+		Certificates: []tls.Certificate{issue(t, ca, "127.0.0.1")},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    ca.Pool(),
+	}))
+
+	c, err := dial(t, b.Addr(), WithTLS(&tls.Config{
+		RootCAs:      ca.Pool(),
+		Certificates: []tls.Certificate{issue(t, ca, "client")},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := metadata(c); err != nil {
+		t.Error(err)
+	}
+
+	// the broker rejects clients without a certificate, or with one from another authority
+	other := newCertificateAuthority(t)
+	for name, certificates := range map[string][]tls.Certificate{
+		"no certificate":  nil,
+		"other authority": {issue(t, other, "client")},
+	} {
+		if _, err := dial(t, b.Addr(), WithTLS(&tls.Config{RootCAs: ca.Pool(), Certificates: certificates})); err == nil {
+			t.Errorf("%s: broker accepted the client", name)
+		}
+	}
+}
+
+func TestTLSUnknownAuthority(t *testing.T) {
+	ca, other := newCertificateAuthority(t), newCertificateAuthority(t)
+	b := newBroker(t, kafkatest.WithTLS(&tls.Config{
+		Certificates: []tls.Certificate{issue(t, ca, "127.0.0.1")},
+	}))
+
+	_, err := dial(t, b.Addr(), WithTLS(&tls.Config{RootCAs: other.Pool()}))
+	var verifyErr *tls.CertificateVerificationError
+	if !errors.As(err, &verifyErr) {
+		t.Errorf("error = %v, want a certificate verification error", err)
+	}
+
+	// the certificate does not name the host that was dialed
+	_, err = dial(t, b.Addr(), WithTLS(&tls.Config{RootCAs: ca.Pool(), ServerName: "broker.example"}))
+	if !errors.As(err, &verifyErr) {
+		t.Errorf("wrong host: error = %v, want a certificate verification error", err)
+	}
+}
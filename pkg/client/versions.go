@@ -0,0 +1,133 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+)
+
+var (
+	ErrNoCompatibleVersion = errors.New("no version supported by both broker and client")
+	ErrApiVersions         = errors.New("api versions request failed")
+)
+
+// VersionRange is a range of versions of an API.
+type VersionRange struct {
+	Min int
+	Max int
+}
+
+// Negotiate sends an ApiVersions request and records the versions supported by the broker. It is
+// called by Dial and, for connections from NewConn, by the first call to RoundTrip. Requests sent
+// after negotiation use the highest version supported by both the broker and this library.
+func (c *Conn) Negotiate(ctx context.Context) error {
+	c.negotiateMu.Lock()
+	defer c.negotiateMu.Unlock()
+	if c.brokerVersions != nil || !c.options.negotiate {
+		return nil
+	}
+
+	api, ok := protocol.Lookup(protocol.ApiVersions)
+	if !ok {
+		return fmt.Errorf("%w: %v", protocol.ErrUnknownApiKey, protocol.ApiVersions)
+	}
+
+	var versions *messages.ApiVersionsResponse
+	for version := api.MaxVersion; ; {
+		req := messages.NewApiVersionsRequest(version)
+		req.ClientSoftwareName = c.options.softwareName
+		req.ClientSoftwareVersion = c.options.softwareVersion
+		frame, err := c.send(ctx, req)
+		if err != nil {
+			return err
+		}
+		if versions, err = decodeApiVersionsResponse(frame, version); err != nil {
+			return err
+		}
+		if protocol.ErrorCode(versions.ErrorCode) != protocol.ErrUnsupportedVersion {
+			break
+		}
+
+		// the broker does not know this version of ApiVersions, and answered with the versions
+		// it supports so that the request can be retried with one of them. Brokers that do not
+		// list ApiVersions itself are retried with v0.
+		next := 0
+		for _, v := range versions.ApiKeys {
+			if protocol.ApiKey(v.ApiKey) == protocol.ApiVersions {
+				next = min(int(v.MaxVersion), api.MaxVersion)
+			}
+		}
+		if next < api.MinVersion || next >= version {
+			return fmt.Errorf("%w: %v v%d is not supported by the broker", ErrApiVersions, protocol.ApiVersions, version)
+		}
+		version = next
+	}
+
+	if versions.ErrorCode != 0 {
+		return fmt.Errorf("%w: %w", ErrApiVersions, protocol.ErrorCode(versions.ErrorCode))
+	}
+	c.brokerVersions = make(map[protocol.ApiKey]VersionRange, len(versions.ApiKeys))
+	for _, v := range versions.ApiKeys {
+		c.brokerVersions[protocol.ApiKey(v.ApiKey)] = VersionRange{Min: int(v.MinVersion), Max: int(v.MaxVersion)}
+	}
+	return nil
+}
+
+// BrokerVersions returns the versions of each API supported by the broker, or nil if versions have
+// not been negotiated.
+func (c *Conn) BrokerVersions() map[protocol.ApiKey]VersionRange {
+	c.negotiateMu.Lock()
+	defer c.negotiateMu.Unlock()
+	if c.brokerVersions == nil {
+		return nil
+	}
+	versions := make(map[protocol.ApiKey]VersionRange, len(c.brokerVersions))
+	for k, v := range c.brokerVersions {
+		versions[k] = v
+	}
+	return versions
+}
+
+// ApiVersion returns the highest version of an API supported by both the broker and this library.
+// Versions must have been negotiated.
+func (c *Conn) ApiVersion(key protocol.ApiKey) (int, error) {
+	api, ok := protocol.Lookup(key)
+	if !ok {
+		return 0, fmt.Errorf("%w: %v", protocol.ErrUnknownApiKey, key)
+	}
+
+	c.negotiateMu.Lock()
+	broker, ok := c.brokerVersions[key]
+	c.negotiateMu.Unlock()
+	if !ok {
+		return 0, fmt.Errorf("%w: broker does not support %v", ErrNoCompatibleVersion, key)
+	}
+
+	lowest, highest := max(api.MinVersion, broker.Min), min(api.MaxVersion, broker.Max)
+	if lowest > highest {
+		return 0, fmt.Errorf("%w: %v broker v%d-%d, client v%d-%d",
+			ErrNoCompatibleVersion, key, broker.Min, broker.Max, api.MinVersion, api.MaxVersion)
+	}
+	return highest, nil
+}
+
+// decodeApiVersionsResponse decodes the response to an ApiVersions request of the given version.
+// A broker that does not support the version of the request answers with an UNSUPPORTED_VERSION
+// error in a v0 response, which is recognized by the error code at the start of the body.
+func decodeApiVersionsResponse(frame []byte, version int) (*messages.ApiVersionsResponse, error) {
+	// ApiVersions responses always use response header v0, which is only the correlation id
+	if len(frame) >= 6 && protocol.ErrorCode(binary.BigEndian.Uint16(frame[4:])) == protocol.ErrUnsupportedVersion {
+		version = 0
+	}
+
+	res := messages.NewApiVersionsResponse(version)
+	if err := messages.DecodeResponse(protocol.NewMessageReader(bytes.NewReader(frame)), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
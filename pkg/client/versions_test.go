@@ -0,0 +1,98 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+)
+
+// negotiate answers the ApiVersions request of c with versions.
+func (b *fakeBroker) negotiate(versions ...messages.ApiVersionsResponseApiVersion) {
+	b.t.Helper()
+	req := b.read()
+	res := messages.NewApiVersionsResponse(req.Version())
+	res.ApiKeys = versions
+	b.write(req, res)
+}
+
+func TestNoCompatibleVersion(t *testing.T) {
+	c, b := newPipe(t)
+	api, _ := protocol.Lookup(protocol.FindCoordinator)
+
+	results := roundTrips(c, "group")
+	b.negotiate(
+		messages.ApiVersionsResponseApiVersion{ApiKey: int16(protocol.ApiVersions), MinVersion: 0, MaxVersion: 3},
+		messages.ApiVersionsResponseApiVersion{ApiKey: int16(protocol.FindCoordinator), MinVersion: int16(api.MaxVersion + 1), MaxVersion: int16(api.MaxVersion + 2)},
+	)
+	if r := <-results; !errors.Is(r.err, ErrNoCompatibleVersion) {
+		t.Fatalf("RoundTrip() error = %v, want ErrNoCompatibleVersion", r.err)
+	}
+	if _, err := c.ApiVersion(protocol.FindCoordinator); !errors.Is(err, ErrNoCompatibleVersion) {
+		t.Errorf("ApiVersion() error = %v", err)
+	}
+
+	// the request was not written
+	b.conn.SetReadDeadline(time.Now().Add(50 * time.Millisecond))
+	if _, err := b.reader.ReadFrame(); !isTimeout(err) {
+		t.Errorf("read a request without a compatible version: %v", err)
+	}
+}
+
+func TestNegotiateFallback(t *testing.T) {
+	c, b := newPipe(t)
+
+	results := roundTrips(c, "group")
+	req := b.read()
+	if req.Version() != 3 {
+		t.Fatalf("ApiVersions v%d, want v3", req.Version())
+	}
+	// a broker that does not know ApiVersions v3 answers with a v0 response, which lists the
+	// versions of ApiVersions it supports
+	unsupported := messages.NewApiVersionsResponse(0)
+	unsupported.ErrorCode = int16(protocol.ErrUnsupportedVersion)
+	unsupported.ApiKeys = []messages.ApiVersionsResponseApiVersion{
+		{ApiKey: int16(protocol.ApiVersions), MinVersion: 0, MaxVersion: 1},
+	}
+	unsupported.SetCorrelationId(req.CorrelationId())
+	var buf bytes.Buffer
+	if err := messages.EncodeResponse(protocol.NewMessageWriter(&buf), unsupported); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.writer.WriteFrame(buf.Bytes(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	req = b.read()
+	if req.Version() != 1 {
+		t.Fatalf("retried ApiVersions v%d, want v1", req.Version())
+	}
+	versions := []messages.ApiVersionsResponseApiVersion{
+		{ApiKey: int16(protocol.ApiVersions), MinVersion: 0, MaxVersion: 1},
+		{ApiKey: int16(protocol.FindCoordinator), MinVersion: 0, MaxVersion: 1},
+	}
+	res := messages.NewApiVersionsResponse(1)
+	res.ApiKeys = versions
+	b.write(req, res)
+
+	fc := b.read()
+	if fc.Version() != 1 {
+		t.Errorf("FindCoordinator v%d, want v1", fc.Version())
+	}
+	b.answer(fc)
+	if r := <-results; r.err != nil {
+		t.Fatal(r.err)
+	}
+
+	want := map[protocol.ApiKey]VersionRange{
+		protocol.ApiVersions:     {Min: 0, Max: 1},
+		protocol.FindCoordinator: {Min: 0, Max: 1},
+	}
+	if got := c.BrokerVersions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("BrokerVersions() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,331 @@
+// Package cluster keeps a view of the brokers, topics and partition leaders of a Kafka cluster,
+// built from Metadata responses.
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/client"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+)
+
+var (
+	ErrClosed    = errors.New("cluster closed")
+	ErrNoBrokers = errors.New("no broker could be reached")
+)
+
+// staleErrors are the errors that show that the metadata of a partition is out of date, because
+// its leader or replicas have moved.
+var staleErrors = map[protocol.ErrorCode]bool{
+	protocol.ErrUnknownTopicOrPartition: true,
+	protocol.ErrLeaderNotAvailable:      true,
+	protocol.ErrNotLeaderOrFollower:     true,
+	protocol.ErrBrokerNotAvailable:      true,
+	protocol.ErrReplicaNotAvailable:     true,
+	protocol.ErrKafkaStorageError:       true,
+	protocol.ErrFencedLeaderEpoch:       true,
+	protocol.ErrUnknownLeaderEpoch:      true,
+	protocol.ErrUnknownTopicId:          true,
+	protocol.ErrInconsistentTopicId:     true,
+}
+
+// Cluster caches the metadata of a cluster. The metadata is refreshed periodically, and in the
+// background when HandleError is given an error that shows it is stale. Any number of goroutines
+// may use a Cluster concurrently.
+type Cluster struct {
+	options   options
+	bootstrap []string
+
+	// refreshMu serializes refreshes, and guards the connection that they are sent over.
+	refreshMu sync.Mutex
+	conn      *client.Conn
+
+	mu       sync.RWMutex
+	metadata *snapshot
+
+	stale     chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// New connects to one of the bootstrap brokers and fetches the metadata of the cluster. Later
+// refreshes are sent to any broker of the cluster, falling back to the bootstrap brokers.
+func New(ctx context.Context, bootstrap []string, opts ...Option) (*Cluster, error) {
+	if len(bootstrap) == 0 {
+		return nil, fmt.Errorf("%w: no bootstrap brokers", ErrNoBrokers)
+	}
+
+	c := &Cluster{
+		options:   newOptions(opts),
+		bootstrap: bootstrap,
+		stale:     make(chan struct{}, 1),
+		closed:    make(chan struct{}),
+	}
+	if err := c.Refresh(ctx); err != nil {
+		c.Close()
+		return nil, err
+	}
+	c.wg.Add(1)
+	go c.run()
+	return c, nil
+}
+
+// Close stops refreshing the metadata and closes the connection to the cluster. The cached
+// metadata can still be read.
+func (c *Cluster) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+	c.wg.Wait()
+
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+	if c.conn != nil {
+		c.conn.Close()
+		c.conn = nil
+	}
+	return nil
+}
+
+// Refresh fetches the metadata of the cluster and replaces the cached metadata with it.
+func (c *Cluster) Refresh(ctx context.Context) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+	select {
+	case <-c.closed:
+		return ErrClosed
+	default:
+	}
+
+	req := messages.NewMetadataRequest(0)
+	req.AllowAutoTopicCreation = false
+	// a null list requests every topic, except in v0 where an empty list does
+	for _, name := range c.options.topics {
+		req.Topics = append(req.Topics, messages.MetadataRequestTopic{Name: &name})
+	}
+	res, err := c.roundTrip(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.metadata = newSnapshot(res.(*messages.MetadataResponse), c.metadata)
+	return nil
+}
+
+// roundTrip sends req over the connection of the last refresh or, if it fails, over a new
+// connection to the first broker that can be reached.
+func (c *Cluster) roundTrip(ctx context.Context, req protocol.Message) (protocol.Message, error) {
+	if c.conn != nil {
+		res, err := c.conn.RoundTrip(ctx, req)
+		if err == nil {
+			return res, nil
+		}
+		c.conn.Close()
+		c.conn = nil
+		if ctx.Err() != nil {
+			return nil, err
+		}
+	}
+
+	var errs []error
+	for _, address := range c.addresses() {
+		conn, err := client.DialContext(ctx, address, c.options.clientOptions...)
+		if err != nil {
+			errs = append(errs, err)
+		} else if res, err := conn.RoundTrip(ctx, req); err != nil {
+			conn.Close()
+			errs = append(errs, err)
+		} else {
+			c.conn = conn
+			return res, nil
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, fmt.Errorf("%w: %w", ErrNoBrokers, errors.Join(errs...))
+}
+
+// addresses returns the addresses of the known brokers, followed by the bootstrap brokers.
+func (c *Cluster) addresses() []string {
+	var addresses []string
+	seen := make(map[string]bool)
+	for _, b := range c.Brokers() {
+		addresses = append(addresses, b.Addr())
+		seen[b.Addr()] = true
+	}
+	for _, address := range c.bootstrap {
+		if !seen[address] {
+			addresses = append(addresses, address)
+			seen[address] = true
+		}
+	}
+	return addresses
+}
+
+// run refreshes the metadata once it is older than the refresh interval, or when it is stale,
+// until the cluster is closed.
+func (c *Cluster) run() {
+	defer c.wg.Done()
+
+	// closing the cluster cancels a refresh in progress
+	done, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-c.closed:
+			cancel()
+		case <-done.Done():
+		}
+	}()
+
+	for {
+		var expired <-chan time.Time
+		if c.options.refreshInterval > 0 {
+			expired = time.After(c.options.refreshInterval)
+		}
+
+		select {
+		case <-c.closed:
+			return
+		case <-expired:
+		case <-c.stale:
+			// refreshes for errors are spaced out so that a failing partition does not flood the
+			// cluster with Metadata requests
+			select {
+			case <-c.closed:
+				return
+			case <-time.After(c.options.refreshBackoff):
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(done, c.options.refreshTimeout)
+		err := c.Refresh(ctx)
+		cancel()
+		if err != nil && !errors.Is(err, ErrClosed) {
+			c.requestRefresh()
+		}
+	}
+}
+
+// requestRefresh makes the metadata refresh in the background.
+func (c *Cluster) requestRefresh() {
+	select {
+	case c.stale <- struct{}{}:
+	default:
+	}
+}
+
+// HandleError refreshes the metadata in the background if err shows that it is stale, such as
+// NOT_LEADER_OR_FOLLOWER from a broker that is no longer the leader of a partition. It reports
+// whether err caused a refresh.
+func (c *Cluster) HandleError(err error) bool {
+	var code protocol.ErrorCode
+	if !errors.As(err, &code) || !staleErrors[code] {
+		return false
+	}
+	c.requestRefresh()
+	return true
+}
+
+func (c *Cluster) snapshot() *snapshot {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.metadata
+}
+
+// ClusterId returns the id of the cluster, or nil before Metadata v2.
+func (c *Cluster) ClusterId() *string {
+	return c.snapshot().clusterId
+}
+
+// ControllerId returns the node id of the controller, or -1 if it is unknown.
+func (c *Cluster) ControllerId() int32 {
+	return c.snapshot().controllerId
+}
+
+// Brokers returns the brokers of the cluster, sorted by node id.
+func (c *Cluster) Brokers() []Broker {
+	s := c.snapshot()
+	if s == nil {
+		return nil
+	}
+	brokers := make([]Broker, 0, len(s.brokers))
+	for _, b := range s.brokers {
+		brokers = append(brokers, b)
+	}
+	sort.Slice(brokers, func(i, j int) bool {
+		return brokers[i].NodeId < brokers[j].NodeId
+	})
+	return brokers
+}
+
+// Broker returns the broker with a node id.
+func (c *Cluster) Broker(nodeId int32) (Broker, bool) {
+	b, ok := c.snapshot().brokers[nodeId]
+	return b, ok
+}
+
+// Topics returns the names of the cached topics, sorted.
+func (c *Cluster) Topics() []string {
+	s := c.snapshot()
+	names := make([]string, 0, len(s.topics))
+	for name := range s.topics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Topic returns the metadata of a topic.
+func (c *Cluster) Topic(name string) (Topic, bool) {
+	t, ok := c.snapshot().topics[name]
+	return t, ok
+}
+
+// Partition returns the metadata of a partition. Unknown topics and partitions fail with
+// ErrUnknownTopicOrPartition, and topics with an error fail with that error. Both refresh the
+// metadata in the background, as the topic may have been created or changed since.
+func (c *Cluster) Partition(topic string, partition int32) (Partition, error) {
+	t, ok := c.Topic(topic)
+	if !ok {
+		c.requestRefresh()
+		return Partition{}, fmt.Errorf("%w: %s", protocol.ErrUnknownTopicOrPartition, topic)
+	}
+	if t.Err != protocol.NoError {
+		c.requestRefresh()
+		return Partition{}, fmt.Errorf("%w: %s", t.Err, topic)
+	}
+	p, ok := t.partition(partition)
+	if !ok {
+		c.requestRefresh()
+		return Partition{}, fmt.Errorf("%w: %s-%d", protocol.ErrUnknownTopicOrPartition, topic, partition)
+	}
+	return p, nil
+}
+
+// LeaderFor returns the broker that leads a partition. Partitions without a leader, or whose
+// leader is not a known broker, fail with ErrLeaderNotAvailable and refresh the metadata in the
+// background.
+func (c *Cluster) LeaderFor(topic string, partition int32) (Broker, error) {
+	p, err := c.Partition(topic, partition)
+	if err != nil {
+		return Broker{}, err
+	}
+	if p.Leader >= 0 {
+		if b, ok := c.Broker(p.Leader); ok {
+			return b, nil
+		}
+	}
+	c.requestRefresh()
+	return Broker{}, fmt.Errorf("%w: %s-%d", protocol.ErrLeaderNotAvailable, topic, partition)
+}
@@ -0,0 +1,156 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/kafkatest"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+)
+
+func newBroker(t *testing.T, opts ...kafkatest.Option) *kafkatest.Broker {
+	t.Helper()
+	b, err := kafkatest.NewBroker(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { b.Close() })
+	return b
+}
+
+func newCluster(t *testing.T, address string, opts ...Option) *Cluster {
+	t.Helper()
+	c, err := New(context.Background(), []string{address}, append([]Option{WithRefreshInterval(0)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestLeaderFor(t *testing.T) {
+	b := newBroker(t, kafkatest.WithNodeId(3))
+	c := newCluster(t, b.Addr(), WithRefreshBackoff(time.Hour))
+
+	if _, err := c.LeaderFor("t", 1); !errors.Is(err, protocol.ErrUnknownTopicOrPartition) {
+		t.Fatalf("LeaderFor() of an unknown topic = %v", err)
+	}
+	if err := b.CreateTopic("t", 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	leader, err := c.LeaderFor("t", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if leader.NodeId != 3 || leader.Addr() != b.Addr() {
+		t.Errorf("LeaderFor() = %+v, want node 3 at %s", leader, b.Addr())
+	}
+	if _, err := c.LeaderFor("t", 2); !errors.Is(err, protocol.ErrUnknownTopicOrPartition) {
+		t.Errorf("LeaderFor() of an unknown partition = %v", err)
+	}
+}
+
+func TestHandleError(t *testing.T) {
+	b := newBroker(t)
+	c := newCluster(t, b.Addr(), WithRefreshBackoff(time.Millisecond))
+	if err := b.CreateTopic("t", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.HandleError(fmt.Errorf("produce: %w", protocol.ErrInvalidRequest)) || c.HandleError(errors.New("eof")) {
+		t.Error("HandleError() refreshed for an error that is not stale metadata")
+	}
+	if !c.HandleError(fmt.Errorf("produce: %w", protocol.ErrNotLeaderOrFollower)) {
+		t.Fatal("HandleError(NOT_LEADER_OR_FOLLOWER) did not refresh")
+	}
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if _, ok := c.Topic("t"); ok {
+			return
+		}
+	}
+	t.Fatal("the metadata was not refreshed")
+}
+
+func TestWithTopics(t *testing.T) {
+	b := newBroker(t, kafkatest.WithAutoCreateTopics(1))
+	for _, name := range []string{"a", "b"} {
+		if err := b.CreateTopic(name, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// refreshes do not create the topics they ask for
+	c := newCluster(t, b.Addr(), WithTopics("a", "missing"))
+	if got := c.Topics(); !reflect.DeepEqual(got, []string{"a", "missing"}) {
+		t.Errorf("Topics() = %v", got)
+	}
+	if topic, _ := c.Topic("missing"); topic.Err != protocol.ErrUnknownTopicOrPartition {
+		t.Errorf("Topic(missing) = %+v", topic)
+	}
+
+	c = newCluster(t, b.Addr(), WithTopics())
+	if got := c.Topics(); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Topics() without WithTopics = %v", got)
+	}
+}
+
+func TestLeaderEpoch(t *testing.T) {
+	m, err := kafkatest.NewMockBroker(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+	host, port, _ := net.SplitHostPort(m.Addr())
+	portNumber, _ := strconv.Atoi(port)
+	id := uuid.New()
+
+	metadata := func(leader, epoch int32) *messages.MetadataResponse {
+		res := messages.NewMetadataResponse(12)
+		for _, node := range []int32{1, 2} {
+			res.Brokers = append(res.Brokers, messages.MetadataResponseBroker{NodeId: node, Host: host, Port: int32(portNumber)})
+		}
+		var p messages.MetadataResponsePartition
+		p.SetDefaults()
+		p.LeaderId, p.LeaderEpoch = leader, epoch
+		name := "t"
+		res.Topics = []messages.MetadataResponseTopic{{Name: &name, TopicId: id, Partitions: []messages.MetadataResponsePartition{p}}}
+		return res
+	}
+	m.Expect(protocol.Metadata).Respond(metadata(1, 5))
+	// a broker that has not seen the election of leader 1 yet
+	m.Expect(protocol.Metadata).Respond(metadata(2, 4))
+	m.Expect(protocol.Metadata).Respond(metadata(2, 6))
+
+	c := newCluster(t, m.Addr())
+	check := func(leader, epoch int32) {
+		t.Helper()
+		p, err := c.Partition("t", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Leader != leader || p.LeaderEpoch != epoch {
+			t.Errorf("partition %+v, want leader %d in epoch %d", p, leader, epoch)
+		}
+	}
+	check(1, 5)
+	for _, want := range []struct{ leader, epoch int32 }{{1, 5}, {2, 6}} {
+		if err := c.Refresh(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		check(want.leader, want.epoch)
+	}
+	m.AssertExpectations()
+}
@@ -0,0 +1,134 @@
+package cluster
+
+import (
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+)
+
+// Broker is a broker of the cluster.
+type Broker struct {
+	NodeId int32
+	Host   string
+	Port   int32
+	Rack   *string
+}
+
+// Addr returns the address to connect to the broker at.
+func (b Broker) Addr() string {
+	return net.JoinHostPort(b.Host, strconv.Itoa(int(b.Port)))
+}
+
+// Topic is the metadata of a topic. Its partitions are sorted by index, and are shared with the
+// cache so they must not be modified.
+type Topic struct {
+	Name     string
+	Id       uuid.UUID
+	Internal bool
+	// Err is the error that the broker reported for the topic, such as ErrLeaderNotAvailable
+	// while the topic is being created.
+	Err        protocol.ErrorCode
+	Partitions []Partition
+}
+
+// Partition is the metadata of a partition. Its slices are shared with the cache and must not be
+// modified.
+type Partition struct {
+	Topic     string
+	Partition int32
+	// Err is the error that the broker reported for the partition, such as
+	// ErrReplicaNotAvailable when a replica is offline.
+	Err protocol.ErrorCode
+	// Leader is the node id of the leader, or -1 if the partition has none.
+	Leader int32
+	// LeaderEpoch is the epoch of the leader, or -1 before Metadata v7.
+	LeaderEpoch     int32
+	Replicas        []int32
+	Isr             []int32
+	OfflineReplicas []int32
+}
+
+// snapshot is the metadata of the cluster from one Metadata response. It is not modified once it
+// is cached.
+type snapshot struct {
+	clusterId    *string
+	controllerId int32
+	brokers      map[int32]Broker
+	topics       map[string]Topic
+}
+
+// newSnapshot builds the snapshot of a Metadata response. Partitions whose leader epoch is older
+// than in prev are kept from prev, as the response may come from a broker that has not yet seen
+// the latest leader election.
+func newSnapshot(res *messages.MetadataResponse, prev *snapshot) *snapshot {
+	s := &snapshot{
+		clusterId:    res.ClusterId,
+		controllerId: res.ControllerId,
+		brokers:      make(map[int32]Broker, len(res.Brokers)),
+		topics:       make(map[string]Topic, len(res.Topics)),
+	}
+	for _, b := range res.Brokers {
+		s.brokers[b.NodeId] = Broker{NodeId: b.NodeId, Host: b.Host, Port: b.Port, Rack: b.Rack}
+	}
+
+	for _, t := range res.Topics {
+		// topics requested by id may be answered without a name
+		if t.Name == nil {
+			continue
+		}
+		topic := Topic{
+			Name:     *t.Name,
+			Id:       t.TopicId,
+			Internal: t.IsInternal,
+			Err:      protocol.ErrorCode(t.ErrorCode),
+		}
+
+		var previous map[int32]Partition
+		if prev != nil {
+			if old, ok := prev.topics[topic.Name]; ok && old.Id == topic.Id {
+				previous = make(map[int32]Partition, len(old.Partitions))
+				for _, p := range old.Partitions {
+					previous[p.Partition] = p
+				}
+			}
+		}
+
+		for _, p := range t.Partitions {
+			partition := Partition{
+				Topic:           topic.Name,
+				Partition:       p.PartitionIndex,
+				Err:             protocol.ErrorCode(p.ErrorCode),
+				Leader:          p.LeaderId,
+				LeaderEpoch:     p.LeaderEpoch,
+				Replicas:        p.ReplicaNodes,
+				Isr:             p.IsrNodes,
+				OfflineReplicas: p.OfflineReplicas,
+			}
+			if old, ok := previous[partition.Partition]; ok && partition.LeaderEpoch >= 0 && old.LeaderEpoch > partition.LeaderEpoch {
+				partition = old
+			}
+			topic.Partitions = append(topic.Partitions, partition)
+		}
+		sort.Slice(topic.Partitions, func(i, j int) bool {
+			return topic.Partitions[i].Partition < topic.Partitions[j].Partition
+		})
+		s.topics[topic.Name] = topic
+	}
+	return s
+}
+
+// partition returns a partition of a topic.
+func (t Topic) partition(partition int32) (Partition, bool) {
+	i := sort.Search(len(t.Partitions), func(i int) bool {
+		return t.Partitions[i].Partition >= partition
+	})
+	if i == len(t.Partitions) || t.Partitions[i].Partition != partition {
+		return Partition{}, false
+	}
+	return t.Partitions[i], true
+}
@@ -0,0 +1,74 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/client"
+)
+
+// DefaultRefreshInterval is the default interval between metadata refreshes, which matches
+// metadata.max.age.ms of the Java client.
+const DefaultRefreshInterval = 5 * time.Minute
+
+// Option configures a Cluster.
+type Option func(*options)
+
+type options struct {
+	clientOptions   []client.Option
+	topics          []string
+	refreshInterval time.Duration
+	refreshBackoff  time.Duration
+	refreshTimeout  time.Duration
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		refreshInterval: DefaultRefreshInterval,
+		refreshBackoff:  100 * time.Millisecond,
+		refreshTimeout:  30 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithClientOptions sets the options of the connections that metadata is requested over, such as
+// TLS and SASL.
+func WithClientOptions(opts ...client.Option) Option {
+	return func(o *options) {
+		o.clientOptions = append(o.clientOptions, opts...)
+	}
+}
+
+// WithTopics limits the cached metadata to the given topics. By default metadata is requested for
+// every topic in the cluster.
+func WithTopics(topics ...string) Option {
+	return func(o *options) {
+		o.topics = append(o.topics, topics...)
+	}
+}
+
+// WithRefreshInterval sets the interval between metadata refreshes. Zero disables periodic
+// refreshes, so that metadata is only refreshed by Refresh and on errors.
+func WithRefreshInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.refreshInterval = interval
+	}
+}
+
+// WithRefreshBackoff sets the least time between refreshes caused by errors, which matches
+// retry.backoff.ms of the Java client. The default is 100ms.
+func WithRefreshBackoff(backoff time.Duration) Option {
+	return func(o *options) {
+		o.refreshBackoff = backoff
+	}
+}
+
+// WithRefreshTimeout sets the time that background refreshes may take, including connecting to a
+// broker. The default is 30s.
+func WithRefreshTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.refreshTimeout = timeout
+	}
+}
@@ -0,0 +1,97 @@
+// Package compress implements the compression codecs of Kafka record batches and message sets.
+package compress
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Id is the id of a codec, as stored in the attributes of record batches and legacy messages.
+type Id int8
+
+const (
+	None   Id = 0
+	Gzip   Id = 1
+	Snappy Id = 2
+	Lz4    Id = 3
+	Zstd   Id = 4
+)
+
+var idNames = map[Id]string{
+	None:   "none",
+	Gzip:   "gzip",
+	Snappy: "snappy",
+	Lz4:    "lz4",
+	Zstd:   "zstd",
+}
+
+func (id Id) String() string {
+	if name, ok := idNames[id]; ok {
+		return name
+	}
+	return fmt.Sprintf("codec(%d)", int8(id))
+}
+
+var (
+	ErrUnknownCodec = errors.New("unknown compression codec")
+	// ErrDecodedSizeExceeded is returned by the built-in codecs when data decodes to more than
+	// 256MB.
+	ErrDecodedSizeExceeded = errors.New("decoded size exceeds the maximum")
+)
+
+// maxDecodedSize caps the size of decoded data, so that a small corrupt or malicious input cannot
+// exhaust memory. It is well above the largest fetch.max.bytes that brokers are run with.
+const maxDecodedSize = 256 << 20
+
+// Codec compresses and decompresses the payload of a record batch or legacy wrapper message.
+type Codec interface {
+	Id() Id
+	// Encode appends the compressed form of src to dst.
+	Encode(dst, src []byte) ([]byte, error)
+	// Decode appends the decompressed form of src to dst.
+	Decode(dst, src []byte) ([]byte, error)
+}
+
+// MagicCodec is implemented by codecs whose format depends on the magic of the record batch or
+// legacy message that holds the compressed data.
+type MagicCodec interface {
+	Codec
+	ForMagic(magic int8) Codec
+}
+
+var (
+	codecsMu sync.RWMutex
+	codecs   = map[Id]Codec{}
+)
+
+// Register makes a codec available to Lookup, replacing any codec registered with the same id.
+func Register(c Codec) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+	codecs[c.Id()] = c
+}
+
+// Lookup returns the codec registered for id.
+func Lookup(id Id) (Codec, error) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+	c, ok := codecs[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownCodec, id)
+	}
+	return c, nil
+}
+
+// LookupMagic returns the codec registered for id, adapted to the given magic if it implements
+// MagicCodec.
+func LookupMagic(id Id, magic int8) (Codec, error) {
+	c, err := Lookup(id)
+	if err != nil {
+		return nil, err
+	}
+	if mc, ok := c.(MagicCodec); ok {
+		return mc.ForMagic(magic), nil
+	}
+	return c, nil
+}
@@ -0,0 +1,176 @@
+package compress
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+func TestRoundTrip(t *testing.T) {
+	src := bytes.Repeat([]byte("kafka protocol "), 10000)
+	for _, id := range []Id{Gzip, Snappy, Lz4, Zstd} {
+		for _, magic := range []int8{0, 1, 2} {
+			c, err := LookupMagic(id, magic)
+			if err != nil {
+				t.Fatal(err)
+			}
+			encoded, err := c.Encode(nil, src)
+			if err != nil {
+				t.Fatalf("%v magic %d: encode: %v", id, magic, err)
+			}
+			decoded, err := c.Decode([]byte("prefix"), encoded)
+			if err != nil {
+				t.Fatalf("%v magic %d: decode: %v", id, magic, err)
+			}
+			if !bytes.Equal(decoded, append([]byte("prefix"), src...)) {
+				t.Errorf("%v magic %d: round trip changed the data", id, magic)
+			}
+		}
+	}
+}
+
+func TestLookupUnknown(t *testing.T) {
+	if _, err := Lookup(Id(7)); err == nil {
+		t.Error("found a codec for id 7")
+	}
+}
+
+func TestZstdMaxDecodedSize(t *testing.T) {
+	// a single segment frame that declares a content size above the limit, followed by an empty
+	// last raw block
+	frame := []byte{0x28, 0xb5, 0x2f, 0xfd, 0xe0}
+	frame = binary.LittleEndian.AppendUint64(frame, maxDecodedSize+1)
+	frame = append(frame, 0x01, 0x00, 0x00)
+
+	_, err := (&ZstdCodec{}).Decode(nil, frame)
+	if !errors.Is(err, ErrDecodedSizeExceeded) || !errors.Is(err, zstd.ErrDecoderSizeExceeded) {
+		t.Errorf("error = %v, want ErrDecodedSizeExceeded", err)
+	}
+}
+
+func TestMaxDecodedSize(t *testing.T) {
+	// large enough for two xerial blocks and two LZ4 blocks
+	src := bytes.Repeat([]byte("kafka protocol "), 10000)
+	codecs := []interface {
+		Codec
+		decode(dst, src []byte, limit int) ([]byte, error)
+	}{
+		&GzipCodec{Level: 1},
+		&SnappyCodec{},
+		&SnappyCodec{Xerial: true},
+		&Lz4Codec{},
+	}
+	for _, c := range codecs {
+		encoded, err := c.Encode(nil, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.decode([]byte("prefix"), encoded, len(src)); err != nil {
+			t.Errorf("%T: decoding to exactly the limit: %v", c, err)
+		}
+		if _, err := c.decode([]byte("prefix"), encoded, len(src)-1); !errors.Is(err, ErrDecodedSizeExceeded) {
+			t.Errorf("%T: error = %v, want ErrDecodedSizeExceeded", c, err)
+		}
+	}
+
+	// an LZ4 frame whose declared content size is above the limit
+	frame := binary.LittleEndian.AppendUint32(nil, lz4FrameMagic)
+	frame = append(frame, lz4FlagVersion|lz4FlagBlockIndep|lz4FlagContentSize, lz4BlockSizeId<<4)
+	frame = binary.LittleEndian.AppendUint64(frame, 11)
+	frame = append(frame, (&Lz4Codec{}).headerChecksum(frame))
+	frame = binary.LittleEndian.AppendUint32(frame, 0)
+	if _, err := (&Lz4Codec{}).decode(nil, frame, 10); !errors.Is(err, ErrDecodedSizeExceeded) {
+		t.Errorf("LZ4 content size: error = %v, want ErrDecodedSizeExceeded", err)
+	}
+}
+
+func TestSnappyXerial(t *testing.T) {
+	// the value of a wrapper message with magic 0 written by the Java client, from the tests of
+	// sarama
+	java := []byte{
+		0x82, 'S', 'N', 'A', 'P', 'P', 'Y', 0, // magic
+		0, 0, 0, 1, // version
+		0, 0, 0, 1, // minimum compatible version
+		0, 0, 0, 22, // block length
+		52, 0, 0, 25, 1, 16, 14, 227, 138, 104, 118, 25, 15, 13, 1, 8, 1, 0, 0, 62, 26, 0,
+	}
+	// two empty messages with magic 0 at offsets 0 and 1
+	var want []byte
+	for offset := range 2 {
+		want = binary.BigEndian.AppendUint64(want, uint64(offset))
+		want = append(want, 0, 0, 0, 14, 0xe3, 0x8a, 0x68, 0x76, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	}
+	got, err := (&SnappyCodec{}).Decode(nil, java)
+	if err != nil || !bytes.Equal(got, want) {
+		t.Errorf("Decode() = %x, %v, want %x", got, err, want)
+	}
+
+	plain := []byte{
+		0x82, 'S', 'N', 'A', 'P', 'P', 'Y', 0, 0, 0, 0, 1, 0, 0, 0, 1,
+		0, 0, 0, 11, 9, 32, 'P', 'L', 'A', 'I', 'N', 'D', 'A', 'T', 'A',
+	}
+	if got, _ := (&SnappyCodec{Xerial: true}).Encode(nil, []byte("PLAINDATA")); !bytes.Equal(got, plain) {
+		t.Errorf("Encode() = %x, want %x", got, plain)
+	}
+	// raw snappy
+	if got, err := (&SnappyCodec{}).Decode(nil, plain[20:]); err != nil || string(got) != "PLAINDATA" {
+		t.Errorf("Decode() = %q, %v", got, err)
+	}
+}
+
+func TestLz4HeaderChecksum(t *testing.T) {
+	// an empty frame with a content checksum, from the tests of sarama
+	frame := []byte{0x04, 0x22, 0x4d, 0x18, 0x64, 0x40, 0xa7, 0, 0, 0, 0, 0x05, 0x5d, 0xcc, 0x02}
+	// the same frame with the checksum of the magic number and descriptor used by magic 0
+	legacy := bytes.Clone(frame)
+	legacy[6] = 0x0c
+
+	magic0 := (&Lz4Codec{}).ForMagic(0)
+	for _, c := range []Codec{&Lz4Codec{}, magic0} {
+		if got, err := c.Decode(nil, frame); err != nil || len(got) != 0 {
+			t.Errorf("%+v: Decode() = %x, %v", c, got, err)
+		}
+	}
+	if got, err := magic0.Decode(nil, legacy); err != nil || len(got) != 0 {
+		t.Errorf("magic 0: Decode() of the legacy checksum = %x, %v", got, err)
+	}
+	if _, err := (&Lz4Codec{}).Decode(nil, legacy); !errors.Is(err, ErrCorruptLz4) {
+		t.Errorf("Decode() of the legacy checksum: error = %v, want ErrCorruptLz4", err)
+	}
+
+	tests := []struct {
+		codec Codec
+		want  []byte
+	}{
+		{&Lz4Codec{}, []byte{0x04, 0x22, 0x4d, 0x18, 0x60, 0x40, 0x82, 0, 0, 0, 0}},
+		{magic0, []byte{0x04, 0x22, 0x4d, 0x18, 0x60, 0x40, 0x1a, 0, 0, 0, 0}},
+	}
+	for _, tt := range tests {
+		if got, _ := tt.codec.Encode(nil, nil); !bytes.Equal(got, tt.want) {
+			t.Errorf("%+v: Encode() = %x, want %x", tt.codec, got, tt.want)
+		}
+	}
+}
+
+func TestXxhash32(t *testing.T) {
+	tests := []struct {
+		data string
+		want uint32
+	}{
+		{"", 0x02cc5d05},
+		{"a", 0x550d7456},
+		{"abc", 0x32d153ff},
+		{"abcd", 0xa3643705},
+		{"abcdefghij", 0x8b988cfe},
+		{"abcdefghijklmnop", 0x9d2d8b62},
+		{"abcdefghijklmnopqrstuvwxyz0123456789", 0x42ae804d},
+	}
+	for _, tt := range tests {
+		if got := xxhash32([]byte(tt.data)); got != tt.want {
+			t.Errorf("xxhash32(%q) = %08x, want %08x", tt.data, got, tt.want)
+		}
+	}
+}
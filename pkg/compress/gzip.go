@@ -0,0 +1,58 @@
+package compress
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+func init() {
+	Register(&GzipCodec{Level: gzip.DefaultCompression})
+}
+
+// GzipCodec is the gzip codec, implemented with the standard library.
+type GzipCodec struct {
+	Level int
+}
+
+func (c *GzipCodec) Id() Id {
+	return Gzip
+}
+
+func (c *GzipCodec) Encode(dst, src []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(dst)
+	w, err := gzip.NewWriterLevel(buf, c.Level)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(src); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *GzipCodec) Decode(dst, src []byte) ([]byte, error) {
+	return c.decode(dst, src, maxDecodedSize)
+}
+
+// decode appends the decoding of src, which may decode to at most limit bytes, to dst.
+func (c *GzipCodec) decode(dst, src []byte, limit int) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(src))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	buf := bytes.NewBuffer(dst)
+	n, err := io.Copy(buf, io.LimitReader(r, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if n > int64(limit) {
+		return nil, ErrDecodedSizeExceeded
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,357 @@
+package compress
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+func init() {
+	Register(&Lz4Codec{})
+}
+
+var (
+	ErrCorruptLz4     = errors.New("corrupt lz4 input")
+	ErrUnsupportedLz4 = errors.New("unsupported lz4 frame")
+)
+
+const (
+	lz4FrameMagic     = 0x184D2204
+	lz4SkippableMagic = 0x184D2A50
+	lz4SkippableMask  = 0xFFFFFFF0
+
+	lz4FlagVersion         = 0x40
+	lz4FlagVersionMask     = 0xC0
+	lz4FlagBlockIndep      = 0x20
+	lz4FlagBlockChecksum   = 0x10
+	lz4FlagContentSize     = 0x08
+	lz4FlagContentChecksum = 0x04
+	lz4FlagDictId          = 0x01
+
+	// lz4BlockSizeId is the id of the 64KB maximum block size, which Kafka uses.
+	lz4BlockSizeId   = 4
+	lz4BlockSize     = 64 * 1024
+	lz4Uncompressed  = 0x80000000
+	lz4MinMatch      = 4
+	lz4MatchLimit    = 12
+	lz4LastLiterals  = 5
+	lz4MaxOffset     = 1<<16 - 1
+	lz4HashTableBits = 16
+)
+
+// Lz4Codec is the LZ4 frame codec.
+//
+// Before Kafka 0.10, the frame header checksum was computed over the magic number as well as the
+// frame descriptor (KIP-57). Messages with magic v0 still use that checksum, which is selected by
+// LegacyHeaderChecksum. A codec with LegacyHeaderChecksum set accepts both checksums on decode.
+type Lz4Codec struct {
+	LegacyHeaderChecksum bool
+}
+
+func (c *Lz4Codec) Id() Id {
+	return Lz4
+}
+
+// ForMagic returns the codec used for messages with the given magic.
+func (c *Lz4Codec) ForMagic(magic int8) Codec {
+	if magic == 0 {
+		return &Lz4Codec{LegacyHeaderChecksum: true}
+	}
+	return &Lz4Codec{}
+}
+
+// Encode writes a single frame of independent 64KB blocks, as written by the Java client.
+func (c *Lz4Codec) Encode(dst, src []byte) ([]byte, error) {
+	start := len(dst)
+	dst = binary.LittleEndian.AppendUint32(dst, lz4FrameMagic)
+	dst = append(dst, lz4FlagVersion|lz4FlagBlockIndep, lz4BlockSizeId<<4)
+	dst = append(dst, c.headerChecksum(dst[start:]))
+
+	var table [1 << lz4HashTableBits]int32
+	for len(src) > 0 {
+		n := len(src)
+		if n > lz4BlockSize {
+			n = lz4BlockSize
+		}
+
+		sizeAt := len(dst)
+		dst = append(dst, 0, 0, 0, 0)
+		dst = lz4EncodeBlock(dst, src[:n], &table)
+		if size := len(dst) - sizeAt - 4; size < n {
+			binary.LittleEndian.PutUint32(dst[sizeAt:], uint32(size))
+		} else {
+			dst = append(dst[:sizeAt+4], src[:n]...)
+			binary.LittleEndian.PutUint32(dst[sizeAt:], uint32(n)|lz4Uncompressed)
+		}
+		src = src[n:]
+	}
+	return binary.LittleEndian.AppendUint32(dst, 0), nil
+}
+
+// headerChecksum returns the checksum of a frame header, which starts with the magic number.
+func (c *Lz4Codec) headerChecksum(header []byte) byte {
+	if c.LegacyHeaderChecksum {
+		return byte(xxhash32(header) >> 8)
+	}
+	return byte(xxhash32(header[4:]) >> 8)
+}
+
+// Decode decodes one or more concatenated frames. Skippable frames are ignored.
+func (c *Lz4Codec) Decode(dst, src []byte) ([]byte, error) {
+	return c.decode(dst, src, maxDecodedSize)
+}
+
+// decode appends the decoding of src, which may decode to at most limit bytes, to dst.
+func (c *Lz4Codec) decode(dst, src []byte, limit int) ([]byte, error) {
+	start := len(dst)
+	for len(src) > 0 {
+		if len(src) < 8 {
+			return nil, ErrCorruptLz4
+		}
+
+		magic := binary.LittleEndian.Uint32(src)
+		if magic&lz4SkippableMask == lz4SkippableMagic {
+			size := binary.LittleEndian.Uint32(src[4:])
+			if uint64(size) > uint64(len(src)-8) {
+				return nil, ErrCorruptLz4
+			}
+			src = src[8+size:]
+			continue
+		}
+		if magic != lz4FrameMagic {
+			return nil, ErrCorruptLz4
+		}
+
+		var err error
+		if dst, src, err = c.decodeFrame(dst, src, limit-(len(dst)-start)); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
+
+// decodeFrame appends the contents of the frame at the start of src to dst, and returns the
+// remainder of src. The frame may decode to at most limit bytes.
+func (c *Lz4Codec) decodeFrame(dst, src []byte, limit int) ([]byte, []byte, error) {
+	flags, bd := src[4], src[5]
+	if flags&lz4FlagVersionMask != lz4FlagVersion {
+		return nil, nil, ErrUnsupportedLz4
+	}
+	if flags&lz4FlagDictId != 0 {
+		return nil, nil, ErrUnsupportedLz4
+	}
+
+	headerLen := 6
+	if flags&lz4FlagContentSize != 0 {
+		headerLen += 8
+	}
+	if len(src) < headerLen+1 {
+		return nil, nil, ErrCorruptLz4
+	}
+	checksum := src[headerLen]
+	if checksum != (&Lz4Codec{}).headerChecksum(src[:headerLen]) &&
+		(!c.LegacyHeaderChecksum || checksum != c.headerChecksum(src[:headerLen])) {
+		return nil, nil, ErrCorruptLz4
+	}
+
+	var contentSize uint64
+	if flags&lz4FlagContentSize != 0 {
+		contentSize = binary.LittleEndian.Uint64(src[6:])
+		if contentSize > uint64(limit) {
+			return nil, nil, ErrDecodedSizeExceeded
+		}
+	}
+
+	sizeId := int(bd>>4) & 7
+	if sizeId < 4 {
+		return nil, nil, ErrCorruptLz4
+	}
+	maxBlock := 1 << (8 + 2*sizeId)
+
+	start := len(dst)
+	src = src[headerLen+1:]
+	for {
+		if len(src) < 4 {
+			return nil, nil, ErrCorruptLz4
+		}
+		size := binary.LittleEndian.Uint32(src)
+		src = src[4:]
+		if size == 0 {
+			break
+		}
+
+		uncompressed := size&lz4Uncompressed != 0
+		size &^= lz4Uncompressed
+		if int(size) > maxBlock || int(size) > len(src) {
+			return nil, nil, ErrCorruptLz4
+		}
+		block := src[:size]
+		src = src[size:]
+
+		if flags&lz4FlagBlockChecksum != 0 {
+			if len(src) < 4 || binary.LittleEndian.Uint32(src) != xxhash32(block) {
+				return nil, nil, ErrCorruptLz4
+			}
+			src = src[4:]
+		}
+
+		if uncompressed {
+			dst = append(dst, block...)
+		} else {
+			var err error
+			if dst, err = lz4DecodeBlock(dst, block, start, maxBlock); err != nil {
+				return nil, nil, err
+			}
+		}
+		if len(dst)-start > limit {
+			return nil, nil, ErrDecodedSizeExceeded
+		}
+	}
+
+	if flags&lz4FlagContentChecksum != 0 {
+		if len(src) < 4 || binary.LittleEndian.Uint32(src) != xxhash32(dst[start:]) {
+			return nil, nil, ErrCorruptLz4
+		}
+		src = src[4:]
+	}
+	if flags&lz4FlagContentSize != 0 && uint64(len(dst)-start) != contentSize {
+		return nil, nil, ErrCorruptLz4
+	}
+	return dst, src, nil
+}
+
+// lz4EncodeBlock appends the LZ4 block encoding of src, which is at most 64KB, to dst using a
+// greedy search for 4 byte matches.
+func lz4EncodeBlock(dst, src []byte, table *[1 << lz4HashTableBits]int32) []byte {
+	for i := range table {
+		table[i] = -1
+	}
+
+	anchor := 0
+	for s := 0; s < len(src)-lz4MatchLimit; {
+		cur := binary.LittleEndian.Uint32(src[s:])
+		h := (cur * xxhPrime1) >> (32 - lz4HashTableBits)
+		candidate := int(table[h])
+		table[h] = int32(s)
+
+		if candidate < 0 || s-candidate > lz4MaxOffset || binary.LittleEndian.Uint32(src[candidate:]) != cur {
+			s++
+			continue
+		}
+
+		length := lz4MinMatch
+		for s+length < len(src)-lz4LastLiterals && src[candidate+length] == src[s+length] {
+			length++
+		}
+		dst = lz4EmitSequence(dst, src[anchor:s], s-candidate, length)
+		s += length
+		anchor = s
+	}
+	return lz4EmitSequence(dst, src[anchor:], 0, 0)
+}
+
+// lz4EmitSequence appends a sequence of literals followed by a match. The last sequence of a block
+// has no match, which is indicated by a length of 0.
+func lz4EmitSequence(dst, literals []byte, offset, length int) []byte {
+	token := byte(lz4TokenLength(len(literals))) << 4
+	if length > 0 {
+		token |= byte(lz4TokenLength(length - lz4MinMatch))
+	}
+	dst = append(dst, token)
+	if len(literals) >= 15 {
+		dst = lz4AppendLength(dst, len(literals)-15)
+	}
+	dst = append(dst, literals...)
+
+	if length == 0 {
+		return dst
+	}
+	dst = append(dst, byte(offset), byte(offset>>8))
+	if length-lz4MinMatch >= 15 {
+		dst = lz4AppendLength(dst, length-lz4MinMatch-15)
+	}
+	return dst
+}
+
+// lz4TokenLength returns the part of a length that is stored in a sequence token.
+func lz4TokenLength(n int) int {
+	if n > 15 {
+		return 15
+	}
+	return n
+}
+
+func lz4AppendLength(dst []byte, n int) []byte {
+	for ; n >= 255; n -= 255 {
+		dst = append(dst, 255)
+	}
+	return append(dst, byte(n))
+}
+
+// lz4DecodeBlock appends the decoding of an LZ4 block to dst. Matches may refer back to the
+// output of earlier blocks of the frame, which starts at dst[base]. The block may decode to at
+// most maxSize bytes.
+func lz4DecodeBlock(dst, src []byte, base, maxSize int) ([]byte, error) {
+	limit := len(dst) + maxSize
+	for i := 0; i < len(src); {
+		token := src[i]
+		i++
+
+		literals := int(token >> 4)
+		if literals == 15 {
+			n, read, err := lz4ReadLength(src[i:])
+			if err != nil {
+				return nil, err
+			}
+			literals += n
+			i += read
+		}
+		if literals > len(src)-i || literals > limit-len(dst) {
+			return nil, ErrCorruptLz4
+		}
+		dst = append(dst, src[i:i+literals]...)
+		i += literals
+		if i == len(src) {
+			break
+		}
+
+		if len(src)-i < 2 {
+			return nil, ErrCorruptLz4
+		}
+		offset := int(binary.LittleEndian.Uint16(src[i:]))
+		i += 2
+
+		length := int(token & 15)
+		if length == 15 {
+			n, read, err := lz4ReadLength(src[i:])
+			if err != nil {
+				return nil, err
+			}
+			length += n
+			i += read
+		}
+		length += lz4MinMatch
+
+		if offset == 0 || offset > len(dst)-base || length > limit-len(dst) {
+			return nil, ErrCorruptLz4
+		}
+		// matches may overlap the bytes they produce, so they are copied one byte at a time
+		for pos := len(dst) - offset; length > 0; length-- {
+			dst = append(dst, dst[pos])
+			pos++
+		}
+	}
+	return dst, nil
+}
+
+// lz4ReadLength reads the continuation bytes of a literal or match length.
+func lz4ReadLength(src []byte) (n, read int, err error) {
+	for read < len(src) {
+		b := src[read]
+		read++
+		n += int(b)
+		if b != 255 {
+			return n, read, nil
+		}
+	}
+	return 0, 0, ErrCorruptLz4
+}
@@ -0,0 +1,257 @@
+package compress
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+func init() {
+	Register(&SnappyCodec{Xerial: true})
+}
+
+var ErrCorruptSnappy = errors.New("corrupt snappy input")
+
+// xerialHeader is the magic header of the block framing used by snappy-java, followed by the
+// version and minimum compatible version of the framing.
+var xerialHeader = []byte{0x82, 'S', 'N', 'A', 'P', 'P', 'Y', 0}
+
+const (
+	xerialVersion   = 1
+	xerialBlockSize = 32 * 1024
+
+	// snappyMaxBlockSize is the largest block the encoder emits copies within, which keeps every
+	// copy offset within two bytes.
+	snappyMaxBlockSize = 64 * 1024
+	snappyTableBits    = 14
+)
+
+// SnappyCodec is the snappy codec. Decode accepts both raw snappy and the xerial framing written
+// by the Java client. Encode writes the xerial framing if Xerial is set and raw snappy otherwise.
+type SnappyCodec struct {
+	Xerial bool
+}
+
+func (c *SnappyCodec) Id() Id {
+	return Snappy
+}
+
+func (c *SnappyCodec) Encode(dst, src []byte) ([]byte, error) {
+	if !c.Xerial {
+		return snappyEncode(dst, src), nil
+	}
+
+	dst = append(dst, xerialHeader...)
+	dst = binary.BigEndian.AppendUint32(dst, xerialVersion)
+	dst = binary.BigEndian.AppendUint32(dst, xerialVersion)
+	for len(src) > 0 {
+		n := len(src)
+		if n > xerialBlockSize {
+			n = xerialBlockSize
+		}
+		start := len(dst)
+		dst = append(dst, 0, 0, 0, 0)
+		dst = snappyEncode(dst, src[:n])
+		binary.BigEndian.PutUint32(dst[start:], uint32(len(dst)-start-4))
+		src = src[n:]
+	}
+	return dst, nil
+}
+
+func (c *SnappyCodec) Decode(dst, src []byte) ([]byte, error) {
+	return c.decode(dst, src, maxDecodedSize)
+}
+
+// decode appends the decoding of src, which may decode to at most limit bytes, to dst.
+func (c *SnappyCodec) decode(dst, src []byte, limit int) ([]byte, error) {
+	if len(src) < len(xerialHeader)+8 || !bytes.Equal(src[:len(xerialHeader)], xerialHeader) {
+		return snappyDecode(dst, src, limit)
+	}
+
+	start := len(dst)
+	src = src[len(xerialHeader)+8:]
+	for len(src) > 0 {
+		if len(src) < 4 {
+			return nil, ErrCorruptSnappy
+		}
+		n := binary.BigEndian.Uint32(src)
+		if uint64(n) > uint64(len(src)-4) {
+			return nil, ErrCorruptSnappy
+		}
+
+		var err error
+		if dst, err = snappyDecode(dst, src[4:4+n], limit-(len(dst)-start)); err != nil {
+			return nil, err
+		}
+		src = src[4+n:]
+	}
+	return dst, nil
+}
+
+// snappyEncode appends the raw snappy encoding of src to dst.
+func snappyEncode(dst, src []byte) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(src)))
+	var table [1 << snappyTableBits]int32
+	for len(src) > 0 {
+		n := len(src)
+		if n > snappyMaxBlockSize {
+			n = snappyMaxBlockSize
+		}
+		dst = snappyEncodeBlock(dst, src[:n], &table)
+		src = src[n:]
+	}
+	return dst
+}
+
+// snappyEncodeBlock appends the literals and copies that encode src, which is at most
+// snappyMaxBlockSize bytes, using a greedy search for 4 byte matches.
+func snappyEncodeBlock(dst, src []byte, table *[1 << snappyTableBits]int32) []byte {
+	for i := range table {
+		table[i] = -1
+	}
+
+	lit := 0
+	for s := 0; s+4 <= len(src); {
+		cur := binary.LittleEndian.Uint32(src[s:])
+		h := (cur * 0x1e35a7bd) >> (32 - snappyTableBits)
+		candidate := int(table[h])
+		table[h] = int32(s)
+
+		if candidate < 0 || binary.LittleEndian.Uint32(src[candidate:]) != cur {
+			s++
+			continue
+		}
+
+		length := 4
+		for s+length < len(src) && src[candidate+length] == src[s+length] {
+			length++
+		}
+		dst = snappyEmitLiteral(dst, src[lit:s])
+		dst = snappyEmitCopy(dst, s-candidate, length)
+		s += length
+		lit = s
+	}
+	return snappyEmitLiteral(dst, src[lit:])
+}
+
+func snappyEmitLiteral(dst, lit []byte) []byte {
+	if len(lit) == 0 {
+		return dst
+	}
+
+	n := len(lit) - 1
+	switch {
+	case n < 60:
+		dst = append(dst, byte(n)<<2)
+	case n < 1<<8:
+		dst = append(dst, 60<<2, byte(n))
+	case n < 1<<16:
+		dst = append(dst, 61<<2, byte(n), byte(n>>8))
+	case n < 1<<24:
+		dst = append(dst, 62<<2, byte(n), byte(n>>8), byte(n>>16))
+	default:
+		dst = append(dst, 63<<2, byte(n), byte(n>>8), byte(n>>16), byte(n>>24))
+	}
+	return append(dst, lit...)
+}
+
+func snappyEmitCopy(dst []byte, offset, length int) []byte {
+	for length >= 68 {
+		dst = append(dst, 63<<2|2, byte(offset), byte(offset>>8))
+		length -= 64
+	}
+	if length > 64 {
+		// leave at least 4 bytes for the last copy
+		dst = append(dst, 59<<2|2, byte(offset), byte(offset>>8))
+		length -= 60
+	}
+	if length >= 12 || offset >= 2048 {
+		return append(dst, byte(length-1)<<2|2, byte(offset), byte(offset>>8))
+	}
+	return append(dst, byte(offset>>8)<<5|byte(length-4)<<2|1, byte(offset))
+}
+
+// snappyDecode appends the decoding of the raw snappy src, which may decode to at most limit bytes,
+// to dst.
+func snappyDecode(dst, src []byte, limit int) ([]byte, error) {
+	length, n := binary.Uvarint(src)
+	// no element decodes to more than 32 times its encoded size, which bounds the declared length
+	if n <= 0 || length > uint64(len(src))*32 {
+		return nil, ErrCorruptSnappy
+	}
+	if length > uint64(limit) {
+		return nil, ErrDecodedSizeExceeded
+	}
+	src = src[n:]
+
+	start := len(dst)
+	end := start + int(length)
+	if cap(dst) < end {
+		grown := make([]byte, len(dst), end)
+		copy(grown, dst)
+		dst = grown
+	}
+
+	for len(src) > 0 {
+		tag := src[0]
+		var offset, size int
+		switch tag & 3 {
+		case 0:
+			size = int(tag >> 2)
+			src = src[1:]
+			if size >= 60 {
+				extra := size - 59
+				if len(src) < extra {
+					return nil, ErrCorruptSnappy
+				}
+				size = 0
+				for i := extra - 1; i >= 0; i-- {
+					size = size<<8 | int(src[i])
+				}
+				src = src[extra:]
+			}
+			size++
+			if size > len(src) || size > end-len(dst) {
+				return nil, ErrCorruptSnappy
+			}
+			dst = append(dst, src[:size]...)
+			src = src[size:]
+			continue
+		case 1:
+			if len(src) < 2 {
+				return nil, ErrCorruptSnappy
+			}
+			size = 4 + int(tag>>2)&7
+			offset = int(tag>>5)<<8 | int(src[1])
+			src = src[2:]
+		case 2:
+			if len(src) < 3 {
+				return nil, ErrCorruptSnappy
+			}
+			size = 1 + int(tag>>2)
+			offset = int(binary.LittleEndian.Uint16(src[1:]))
+			src = src[3:]
+		case 3:
+			if len(src) < 5 {
+				return nil, ErrCorruptSnappy
+			}
+			size = 1 + int(tag>>2)
+			offset = int(binary.LittleEndian.Uint32(src[1:]))
+			src = src[5:]
+		}
+
+		if offset <= 0 || offset > len(dst)-start || size > end-len(dst) {
+			return nil, ErrCorruptSnappy
+		}
+		// copies may overlap the bytes they produce, so they are made one byte at a time
+		for pos := len(dst) - offset; size > 0; size-- {
+			dst = append(dst, dst[pos])
+			pos++
+		}
+	}
+
+	if len(dst) != end {
+		return nil, ErrCorruptSnappy
+	}
+	return dst, nil
+}
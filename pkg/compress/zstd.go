@@ -0,0 +1,81 @@
+package compress
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+func init() {
+	Register(&ZstdCodec{})
+}
+
+// ZstdCodec is the zstd codec.
+type ZstdCodec struct {
+	// Level is the zstd compression level used to encode, as in the compression.zstd.level
+	// config of the Java client. Zero selects the default level of 3.
+	Level int
+}
+
+var (
+	zstdEncoders   sync.Map // level -> *zstd.Encoder
+	zstdDecoder    *zstd.Decoder
+	zstdDecoderErr error
+	zstdInit       sync.Once
+)
+
+func (c *ZstdCodec) Id() Id {
+	return Zstd
+}
+
+func (c *ZstdCodec) Encode(dst, src []byte) ([]byte, error) {
+	enc, err := zstdEncoder(c.Level)
+	if err != nil {
+		return nil, err
+	}
+	return enc.EncodeAll(src, dst), nil
+}
+
+func (c *ZstdCodec) Decode(dst, src []byte) ([]byte, error) {
+	dec, err := zstdSharedDecoder()
+	if err != nil {
+		return nil, err
+	}
+	dst, err = dec.DecodeAll(src, dst)
+	if errors.Is(err, zstd.ErrDecoderSizeExceeded) {
+		return nil, fmt.Errorf("%w: %w", ErrDecodedSizeExceeded, err)
+	}
+	return dst, err
+}
+
+// zstdSharedDecoder returns the decoder shared by every codec, creating it on first use.
+func zstdSharedDecoder() (*zstd.Decoder, error) {
+	zstdInit.Do(func() {
+		zstdDecoder, zstdDecoderErr = zstd.NewReader(nil,
+			zstd.WithDecoderConcurrency(0),
+			zstd.WithDecoderMaxMemory(maxDecodedSize))
+	})
+	return zstdDecoder, zstdDecoderErr
+}
+
+// zstdEncoder returns the shared encoder for a level. Encoders are safe for concurrent use by
+// EncodeAll and expensive to create, so one is kept per level.
+func zstdEncoder(level int) (*zstd.Encoder, error) {
+	if level == 0 {
+		level = 3
+	}
+	if enc, ok := zstdEncoders.Load(level); ok {
+		return enc.(*zstd.Encoder), nil
+	}
+
+	enc, err := zstd.NewWriter(nil,
+		zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+		zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := zstdEncoders.LoadOrStore(level, enc)
+	return actual.(*zstd.Encoder), nil
+}
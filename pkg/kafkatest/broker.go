@@ -0,0 +1,263 @@
+// Package kafkatest provides an in-process stand-in for a Kafka broker, for testing clients
+// without a cluster.
+package kafkatest
+
+import (
+	"crypto/tls"
+	"sync"
+	"time"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+	"github.com/ethanmoffat/kafka-protocol/pkg/sasl"
+)
+
+// Option configures a Broker.
+type Option func(*options)
+
+type options struct {
+	address        string
+	tls            *tls.Config
+	nodeId         int32
+	clusterId      string
+	autoCreate     bool
+	autoPartitions int
+
+	scram           bool
+	mechanisms      []sasl.ServerMechanism
+	sessionLifetime time.Duration
+
+	versions map[protocol.ApiKey]VersionRange
+}
+
+// WithAddress sets the address that the broker listens on. The default is a random port on the
+// loopback interface.
+func WithAddress(address string) Option {
+	return func(o *options) {
+		o.address = address
+	}
+}
+
+// WithTLS makes the broker serve TLS connections with config, which holds the certificate of the
+// broker. Setting config.ClientAuth to tls.RequireAndVerifyClientCert requires mutual TLS, as
+// ssl.client.auth=required does. A CertificateAuthority issues certificates for tests.
+func WithTLS(config *tls.Config) Option {
+	return func(o *options) {
+		o.tls = config
+	}
+}
+
+// WithNodeId sets the node id that the broker reports in metadata.
+func WithNodeId(nodeId int32) Option {
+	return func(o *options) {
+		o.nodeId = nodeId
+	}
+}
+
+// WithClusterId sets the cluster id that the broker reports in metadata.
+func WithClusterId(clusterId string) Option {
+	return func(o *options) {
+		o.clusterId = clusterId
+	}
+}
+
+// WithAutoCreateTopics makes metadata requests that allow it create unknown topics with the given
+// number of partitions, as auto.create.topics.enable does.
+func WithAutoCreateTopics(partitions int) Option {
+	return func(o *options) {
+		o.autoCreate = true
+		o.autoPartitions = partitions
+	}
+}
+
+// WithScram requires clients to authenticate with SCRAM-SHA-256 or SCRAM-SHA-512 before sending
+// requests other than ApiVersions. Credentials are created with SetScramCredential or
+// AlterUserScramCredentials requests.
+func WithScram() Option {
+	return func(o *options) {
+		o.scram = true
+	}
+}
+
+// WithSaslMechanisms requires clients to authenticate with one of the given mechanisms before
+// sending requests other than ApiVersions, in addition to SCRAM if WithScram is set. The
+// OAUTHBEARER mechanism can be tested with a sasl.OAuthBearerServer that accepts fixed tokens.
+func WithSaslMechanisms(mechanisms ...sasl.ServerMechanism) Option {
+	return func(o *options) {
+		o.mechanisms = append(o.mechanisms, mechanisms...)
+	}
+}
+
+// WithSessionLifetime limits the lifetime of SASL sessions, which is sent to clients in
+// SaslAuthenticate v1 responses (KIP-368). Connections whose session has expired are closed when
+// they send a request other than ApiVersions or SaslHandshake.
+func WithSessionLifetime(lifetime time.Duration) Option {
+	return func(o *options) {
+		o.sessionLifetime = lifetime
+	}
+}
+
+// WithVersions limits the versions of an API that the broker advertises in ApiVersions responses,
+// so that clients use older versions, such as SaslHandshake v0 and its unframed SASL tokens.
+// Requests are still answered at any version.
+func WithVersions(key protocol.ApiKey, min, max int) Option {
+	return func(o *options) {
+		if o.versions == nil {
+			o.versions = make(map[protocol.ApiKey]VersionRange)
+		}
+		o.versions[key] = VersionRange{Min: min, Max: max}
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		address:        "127.0.0.1:0",
+		clusterId:      "kafkatest",
+		autoPartitions: 1,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// handledApis are the APIs that the broker answers. Requests for other APIs close the connection.
+var handledApis = []protocol.ApiKey{
+	protocol.Produce,
+	protocol.Fetch,
+	protocol.ListOffsets,
+	protocol.Metadata,
+	protocol.OffsetCommit,
+	protocol.OffsetFetch,
+	protocol.FindCoordinator,
+	protocol.JoinGroup,
+	protocol.Heartbeat,
+	protocol.LeaveGroup,
+	protocol.SyncGroup,
+	protocol.SaslHandshake,
+	protocol.ApiVersions,
+	protocol.SaslAuthenticate,
+	protocol.DescribeUserScramCredentials,
+	protocol.AlterUserScramCredentials,
+}
+
+// Broker is a single-node Kafka cluster that keeps topics, committed offsets and consumer groups
+// in memory. It answers ApiVersions, Metadata, Produce, Fetch, ListOffsets and the group
+// coordination APIs.
+type Broker struct {
+	options options
+	server  *server
+
+	mu       sync.Mutex
+	topics   map[string]*topic
+	groups   map[string]*group
+	appended chan struct{}
+	// scram holds the SCRAM credentials of each user.
+	scram map[string]map[sasl.ScramMechanism]sasl.ScramCredential
+}
+
+// NewBroker starts a broker that listens on a local port.
+func NewBroker(opts ...Option) (*Broker, error) {
+	o := newOptions(opts)
+	s, err := listen(o.address, o.tls)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Broker{
+		options:  o,
+		server:   s,
+		topics:   make(map[string]*topic),
+		groups:   make(map[string]*group),
+		appended: make(chan struct{}),
+		scram:    make(map[string]map[sasl.ScramMechanism]sasl.ScramCredential),
+	}
+	s.handleSasl = b.saslToken
+	s.start(b.handle)
+	return b, nil
+}
+
+// Addr returns the address that the broker listens on.
+func (b *Broker) Addr() string {
+	return b.server.Addr()
+}
+
+// Close stops the broker and closes every connection to it.
+func (b *Broker) Close() error {
+	return b.server.Close()
+}
+
+// handle answers req. The response is nil for requests that get no response, and ok is false for
+// requests that the broker does not handle, or that are sent before authenticating.
+func (b *Broker) handle(c *connState, req protocol.Message) (res protocol.Message, ok bool) {
+	switch req.ApiKey() {
+	case protocol.ApiVersions, protocol.SaslHandshake, protocol.SaslAuthenticate:
+	default:
+		if !b.authenticated(c) {
+			return nil, false
+		}
+	}
+
+	switch req := req.(type) {
+	case *messages.ApiVersionsRequest:
+		res = b.apiVersions(req)
+	case *messages.SaslHandshakeRequest:
+		res = b.saslHandshake(c, req)
+	case *messages.SaslAuthenticateRequest:
+		res = b.saslAuthenticate(c, req)
+	case *messages.DescribeUserScramCredentialsRequest:
+		res = b.describeUserScramCredentials(req)
+	case *messages.AlterUserScramCredentialsRequest:
+		res = b.alterUserScramCredentials(req)
+	case *messages.MetadataRequest:
+		res = b.metadata(req)
+	case *messages.ProduceRequest:
+		produced := b.produce(req)
+		if req.Acks == 0 {
+			return nil, true
+		}
+		res = produced
+	case *messages.FetchRequest:
+		res = b.fetch(req)
+	case *messages.ListOffsetsRequest:
+		res = b.listOffsets(req)
+	case *messages.FindCoordinatorRequest:
+		res = b.findCoordinator(req)
+	case *messages.JoinGroupRequest:
+		res = b.joinGroup(req)
+	case *messages.SyncGroupRequest:
+		res = b.syncGroup(req)
+	case *messages.HeartbeatRequest:
+		res = b.heartbeat(req)
+	case *messages.LeaveGroupRequest:
+		res = b.leaveGroup(req)
+	case *messages.OffsetCommitRequest:
+		res = b.offsetCommit(req)
+	case *messages.OffsetFetchRequest:
+		res = b.offsetFetch(req)
+	default:
+		return nil, false
+	}
+	res.SetCorrelationId(req.CorrelationId())
+	return res, true
+}
+
+func (b *Broker) apiVersions(req *messages.ApiVersionsRequest) *messages.ApiVersionsResponse {
+	res := messages.NewApiVersionsResponse(req.Version())
+	for _, key := range handledApis {
+		api, ok := protocol.Lookup(key)
+		if !ok {
+			continue
+		}
+		versions, ok := b.options.versions[key]
+		if !ok {
+			versions = VersionRange{Min: api.MinVersion, Max: api.MaxVersion}
+		}
+		res.ApiKeys = append(res.ApiKeys, messages.ApiVersionsResponseApiVersion{
+			ApiKey:     int16(key),
+			MinVersion: int16(versions.Min),
+			MaxVersion: int16(versions.Max),
+		})
+	}
+	return res
+}
@@ -0,0 +1,79 @@
+package kafkatest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+)
+
+// maxDumpBytes is the number of bytes of a bytes field that Dump shows.
+const maxDumpBytes = 64
+
+// Dump returns a readable, multi-line description of the fields of a decoded message, for
+// reporting requests in test failures.
+func Dump(m protocol.Message) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s v%d ", reflect.TypeOf(m).Elem().Name(), m.Version())
+	dumpValue(&b, reflect.ValueOf(m).Elem(), 0)
+	return b.String()
+}
+
+func dumpValue(b *strings.Builder, v reflect.Value, depth int) {
+	indent := strings.Repeat("  ", depth)
+	if s, ok := v.Interface().(fmt.Stringer); ok && v.Kind() != reflect.Struct {
+		b.WriteString(s.String())
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			b.WriteString("null")
+			return
+		}
+		dumpValue(b, v.Elem(), depth)
+	case reflect.String:
+		fmt.Fprintf(b, "%q", v.String())
+	case reflect.Slice:
+		if v.IsNil() {
+			b.WriteString("null")
+			return
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			data := v.Bytes()
+			if len(data) > maxDumpBytes {
+				fmt.Fprintf(b, "%d bytes %x...", len(data), data[:maxDumpBytes])
+			} else {
+				fmt.Fprintf(b, "%d bytes %x", len(data), data)
+			}
+			return
+		}
+		if v.Len() == 0 {
+			b.WriteString("[]")
+			return
+		}
+		b.WriteString("[\n")
+		for i := 0; i < v.Len(); i++ {
+			b.WriteString(indent + "  ")
+			dumpValue(b, v.Index(i), depth+1)
+			b.WriteString("\n")
+		}
+		b.WriteString(indent + "]")
+	case reflect.Struct:
+		b.WriteString("{\n")
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if !f.IsExported() || (f.Name == "UnknownTaggedFields" && v.Field(i).Len() == 0) {
+				continue
+			}
+			fmt.Fprintf(b, "%s  %s: ", indent, f.Name)
+			dumpValue(b, v.Field(i), depth+1)
+			b.WriteString("\n")
+		}
+		b.WriteString(indent + "}")
+	default:
+		fmt.Fprint(b, v.Interface())
+	}
+}
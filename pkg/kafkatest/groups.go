@@ -0,0 +1,603 @@
+package kafkatest
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+)
+
+type groupState int
+
+const (
+	groupEmpty groupState = iota
+	groupPreparingRebalance
+	groupCompletingRebalance
+	groupStable
+)
+
+// group is a consumer group and its committed offsets. Members are not expired when they stop
+// sending heartbeats; they leave with LeaveGroup or by not rejoining a rebalance in time.
+type group struct {
+	id           string
+	state        groupState
+	generation   int32
+	protocolType string
+	protocolName string
+	leader       string
+	members      map[string]*member
+	offsets      map[string]map[int32]committedOffset
+	// pending holds the member ids given out with MEMBER_ID_REQUIRED that have not joined yet.
+	pending map[string]bool
+
+	// rebalance identifies the current rebalance, so that the timer of an earlier one is ignored.
+	rebalance int
+	timer     *time.Timer
+}
+
+type member struct {
+	id               string
+	instanceId       *string
+	protocols        []messages.JoinGroupRequestProtocol
+	rebalanceTimeout time.Duration
+	assignment       []byte
+
+	// join and sync are set while the member waits for the rebalance to complete.
+	join chan joinResult
+	sync chan syncResult
+}
+
+type joinResult struct {
+	errorCode    protocol.ErrorCode
+	generation   int32
+	protocolType string
+	protocolName string
+	leader       string
+	memberId     string
+	// members is only set for the leader.
+	members []messages.JoinGroupResponseMember
+}
+
+type syncResult struct {
+	errorCode  protocol.ErrorCode
+	assignment []byte
+}
+
+type committedOffset struct {
+	offset      int64
+	leaderEpoch int32
+	metadata    *string
+}
+
+func (b *Broker) group(id string) *group {
+	g, ok := b.groups[id]
+	if !ok {
+		g = &group{
+			id:      id,
+			members: make(map[string]*member),
+			offsets: make(map[string]map[int32]committedOffset),
+			pending: make(map[string]bool),
+		}
+		b.groups[id] = g
+	}
+	return g
+}
+
+func (b *Broker) findCoordinator(req *messages.FindCoordinatorRequest) *messages.FindCoordinatorResponse {
+	res := messages.NewFindCoordinatorResponse(req.Version())
+	res.NodeId, res.Host, res.Port = b.options.nodeId, b.server.host, b.server.port
+	for _, key := range req.CoordinatorKeys {
+		res.Coordinators = append(res.Coordinators, messages.FindCoordinatorResponseCoordinator{
+			Key:    key,
+			NodeId: b.options.nodeId,
+			Host:   b.server.host,
+			Port:   b.server.port,
+		})
+	}
+	return res
+}
+
+// joinGroup adds the member to the group, or rejoins it, and waits for the rebalance to complete.
+func (b *Broker) joinGroup(req *messages.JoinGroupRequest) *messages.JoinGroupResponse {
+	res := messages.NewJoinGroupResponse(req.Version())
+	res.MemberId = req.MemberId
+	fail := func(code protocol.ErrorCode) *messages.JoinGroupResponse {
+		res.ErrorCode = int16(code)
+		return res
+	}
+
+	b.mu.Lock()
+	if req.GroupId == "" {
+		b.mu.Unlock()
+		return fail(protocol.ErrInvalidGroupId)
+	}
+	g := b.group(req.GroupId)
+	if len(g.members) > 0 && (req.ProtocolType != g.protocolType || !g.supportsProtocols(req.Protocols)) {
+		b.mu.Unlock()
+		return fail(protocol.ErrInconsistentGroupProtocol)
+	}
+
+	m := g.members[req.MemberId]
+	switch {
+	case g.pending[req.MemberId]:
+		delete(g.pending, req.MemberId)
+		m = &member{id: req.MemberId, instanceId: req.GroupInstanceId}
+		g.members[m.id] = m
+	case req.MemberId != "" && m == nil:
+		b.mu.Unlock()
+		return fail(protocol.ErrUnknownMemberId)
+	case req.MemberId == "":
+		if req.GroupInstanceId != nil {
+			// a static member that restarts replaces its previous member id
+			for id, old := range g.members {
+				if old.instanceId != nil && *old.instanceId == *req.GroupInstanceId {
+					g.removeMember(id)
+				}
+			}
+		}
+		res.MemberId = uuid.NewString()
+		if req.Version() >= 4 && req.GroupInstanceId == nil {
+			// KIP-394: the member joins again with the id that it was given
+			g.pending[res.MemberId] = true
+			b.mu.Unlock()
+			return fail(protocol.ErrMemberIdRequired)
+		}
+		m = &member{id: res.MemberId, instanceId: req.GroupInstanceId}
+		g.members[m.id] = m
+	}
+
+	m.protocols = req.Protocols
+	m.rebalanceTimeout = time.Duration(req.RebalanceTimeoutMs) * time.Millisecond
+	if req.RebalanceTimeoutMs < 0 {
+		m.rebalanceTimeout = time.Duration(req.SessionTimeoutMs) * time.Millisecond
+	}
+	if m.join != nil {
+		// the new request replaces an earlier one of the member that is still waiting
+		m.join <- joinResult{errorCode: protocol.ErrRebalanceInProgress}
+	}
+	ch := make(chan joinResult, 1)
+	m.join = ch
+	g.protocolType = req.ProtocolType
+	b.prepareRebalance(g)
+	g.maybeCompleteJoin()
+	b.mu.Unlock()
+
+	var result joinResult
+	select {
+	case result = <-ch:
+	case <-b.server.closed:
+		return fail(protocol.ErrCoordinatorNotAvailable)
+	}
+	if result.errorCode != protocol.NoError {
+		return fail(result.errorCode)
+	}
+
+	res.GenerationId = result.generation
+	res.ProtocolType = &result.protocolType
+	res.ProtocolName = &result.protocolName
+	res.Leader = result.leader
+	res.MemberId = result.memberId
+	res.Members = result.members
+	return res
+}
+
+// supportsProtocols reports whether protocols has one that every member of the group supports.
+func (g *group) supportsProtocols(protocols []messages.JoinGroupRequestProtocol) bool {
+	for _, p := range protocols {
+		if g.supportsProtocol(p.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *group) supportsProtocol(name string) bool {
+	for _, m := range g.members {
+		supported := false
+		for _, p := range m.protocols {
+			supported = supported || p.Name == name
+		}
+		if !supported {
+			return false
+		}
+	}
+	return true
+}
+
+// prepareRebalance starts a rebalance of the group if one is not already in progress. Members
+// that do not rejoin before the largest rebalance timeout of the group are removed.
+func (b *Broker) prepareRebalance(g *group) {
+	if g.state == groupPreparingRebalance {
+		return
+	}
+	g.state = groupPreparingRebalance
+	for _, m := range g.members {
+		if m.sync != nil {
+			m.sync <- syncResult{errorCode: protocol.ErrRebalanceInProgress}
+			m.sync = nil
+		}
+	}
+
+	var timeout time.Duration
+	for _, m := range g.members {
+		if m.rebalanceTimeout > timeout {
+			timeout = m.rebalanceTimeout
+		}
+	}
+	g.rebalance++
+	rebalance := g.rebalance
+	g.timer = time.AfterFunc(timeout, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if g.rebalance != rebalance || g.state != groupPreparingRebalance {
+			return
+		}
+		for id, m := range g.members {
+			if m.join == nil {
+				g.removeMember(id)
+			}
+		}
+		g.completeJoin()
+	})
+}
+
+// maybeCompleteJoin completes the rebalance once every member has rejoined.
+func (g *group) maybeCompleteJoin() {
+	for _, m := range g.members {
+		if m.join == nil {
+			return
+		}
+	}
+	g.completeJoin()
+}
+
+// completeJoin starts a new generation with the members that rejoined, and answers their join
+// requests. The leader is kept if it rejoined, and the protocol is the first one of the leader
+// that every member supports.
+func (g *group) completeJoin() {
+	if g.timer != nil {
+		g.timer.Stop()
+		g.timer = nil
+	}
+	g.generation++
+	if len(g.members) == 0 {
+		g.state, g.leader, g.protocolName = groupEmpty, "", ""
+		return
+	}
+
+	ids := make([]string, 0, len(g.members))
+	for id := range g.members {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	if _, ok := g.members[g.leader]; !ok {
+		g.leader = ids[0]
+	}
+
+	g.protocolName = ""
+	for _, p := range g.members[g.leader].protocols {
+		if g.supportsProtocol(p.Name) {
+			g.protocolName = p.Name
+			break
+		}
+	}
+
+	var members []messages.JoinGroupResponseMember
+	for _, id := range ids {
+		m := g.members[id]
+		for _, p := range m.protocols {
+			if p.Name == g.protocolName {
+				members = append(members, messages.JoinGroupResponseMember{
+					MemberId:        id,
+					GroupInstanceId: m.instanceId,
+					Metadata:        p.Metadata,
+				})
+			}
+		}
+	}
+
+	g.state = groupCompletingRebalance
+	for _, id := range ids {
+		m := g.members[id]
+		result := joinResult{
+			generation:   g.generation,
+			protocolType: g.protocolType,
+			protocolName: g.protocolName,
+			leader:       g.leader,
+			memberId:     id,
+		}
+		if id == g.leader {
+			result.members = members
+		}
+		m.join <- result
+		m.join = nil
+		m.assignment = nil
+	}
+}
+
+// removeMember removes a member from the group, failing its pending requests.
+func (g *group) removeMember(id string) {
+	m, ok := g.members[id]
+	if !ok {
+		return
+	}
+	if m.join != nil {
+		m.join <- joinResult{errorCode: protocol.ErrUnknownMemberId}
+	}
+	if m.sync != nil {
+		m.sync <- syncResult{errorCode: protocol.ErrUnknownMemberId}
+	}
+	delete(g.members, id)
+}
+
+// syncGroup records the assignments sent by the leader and waits for the assignment of the
+// member.
+func (b *Broker) syncGroup(req *messages.SyncGroupRequest) *messages.SyncGroupResponse {
+	res := messages.NewSyncGroupResponse(req.Version())
+
+	b.mu.Lock()
+	g := b.groups[req.GroupId]
+	code, m := b.checkMember(g, req.MemberId, req.GenerationId)
+	if code == protocol.NoError && g.state == groupPreparingRebalance {
+		code = protocol.ErrRebalanceInProgress
+	}
+	if code != protocol.NoError {
+		b.mu.Unlock()
+		res.ErrorCode = int16(code)
+		return res
+	}
+	protocolType, protocolName := g.protocolType, g.protocolName
+	res.ProtocolType, res.ProtocolName = &protocolType, &protocolName
+
+	if g.state == groupCompletingRebalance && req.MemberId == g.leader {
+		for _, a := range req.Assignments {
+			if assigned, ok := g.members[a.MemberId]; ok {
+				assigned.assignment = a.Assignment
+			}
+		}
+		g.state = groupStable
+		for _, other := range g.members {
+			if other.sync != nil {
+				other.sync <- syncResult{assignment: other.assignment}
+				other.sync = nil
+			}
+		}
+	}
+	if g.state == groupStable {
+		res.Assignment = m.assignment
+		b.mu.Unlock()
+		return res
+	}
+
+	if m.sync != nil {
+		m.sync <- syncResult{errorCode: protocol.ErrRebalanceInProgress}
+	}
+	ch := make(chan syncResult, 1)
+	m.sync = ch
+	b.mu.Unlock()
+
+	select {
+	case result := <-ch:
+		res.ErrorCode = int16(result.errorCode)
+		res.Assignment = result.assignment
+	case <-b.server.closed:
+		res.ErrorCode = int16(protocol.ErrCoordinatorNotAvailable)
+	}
+	return res
+}
+
+// checkMember checks that memberId is a member of g in the given generation.
+func (b *Broker) checkMember(g *group, memberId string, generation int32) (protocol.ErrorCode, *member) {
+	if g == nil {
+		return protocol.ErrUnknownMemberId, nil
+	}
+	m, ok := g.members[memberId]
+	if !ok {
+		return protocol.ErrUnknownMemberId, nil
+	}
+	if generation != g.generation {
+		return protocol.ErrIllegalGeneration, m
+	}
+	return protocol.NoError, m
+}
+
+func (b *Broker) heartbeat(req *messages.HeartbeatRequest) *messages.HeartbeatResponse {
+	res := messages.NewHeartbeatResponse(req.Version())
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	g := b.groups[req.GroupId]
+	code, _ := b.checkMember(g, req.MemberId, req.GenerationId)
+	if code == protocol.NoError && g.state == groupPreparingRebalance {
+		code = protocol.ErrRebalanceInProgress
+	}
+	res.ErrorCode = int16(code)
+	return res
+}
+
+func (b *Broker) leaveGroup(req *messages.LeaveGroupRequest) *messages.LeaveGroupResponse {
+	res := messages.NewLeaveGroupResponse(req.Version())
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	g := b.groups[req.GroupId]
+
+	// v3+ removes a batch of members, which may be identified by their instance id
+	identities := req.Members
+	if req.Version() < 3 {
+		identities = []messages.LeaveGroupRequestMemberIdentity{{MemberId: req.MemberId}}
+	}
+
+	left := false
+	for _, identity := range identities {
+		mr := messages.LeaveGroupResponseMemberResponse{
+			MemberId:        identity.MemberId,
+			GroupInstanceId: identity.GroupInstanceId,
+			ErrorCode:       int16(protocol.ErrUnknownMemberId),
+		}
+		if g != nil {
+			for id, m := range g.members {
+				if id == identity.MemberId || (identity.GroupInstanceId != nil && m.instanceId != nil && *m.instanceId == *identity.GroupInstanceId) {
+					g.removeMember(id)
+					mr.ErrorCode = int16(protocol.NoError)
+					left = true
+				}
+			}
+		}
+		res.Members = append(res.Members, mr)
+	}
+
+	if req.Version() < 3 {
+		res.ErrorCode, res.Members = res.Members[0].ErrorCode, nil
+	}
+	if left {
+		b.prepareRebalance(g)
+		g.maybeCompleteJoin()
+	}
+	return res
+}
+
+func (b *Broker) offsetCommit(req *messages.OffsetCommitRequest) *messages.OffsetCommitResponse {
+	res := messages.NewOffsetCommitResponse(req.Version())
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var code protocol.ErrorCode
+	var g *group
+	switch {
+	case req.GroupId == "":
+		code = protocol.ErrInvalidGroupId
+	case req.GenerationIdOrMemberEpoch < 0:
+		g = b.group(req.GroupId)
+		// commits from outside of the group are only accepted while it has no members
+		if len(g.members) > 0 {
+			code = protocol.ErrUnknownMemberId
+		}
+	default:
+		g = b.group(req.GroupId)
+		code, _ = b.checkMember(g, req.MemberId, req.GenerationIdOrMemberEpoch)
+		if code == protocol.NoError && g.state == groupPreparingRebalance {
+			code = protocol.ErrRebalanceInProgress
+		}
+	}
+
+	for _, rt := range req.Topics {
+		tr := messages.OffsetCommitResponseTopic{Name: rt.Name}
+		for _, rp := range rt.Partitions {
+			pr := messages.OffsetCommitResponsePartition{PartitionIndex: rp.PartitionIndex, ErrorCode: int16(code)}
+			switch {
+			case code != protocol.NoError:
+			case b.partition(rt.Name, rp.PartitionIndex) == nil:
+				pr.ErrorCode = int16(protocol.ErrUnknownTopicOrPartition)
+			default:
+				if g.offsets[rt.Name] == nil {
+					g.offsets[rt.Name] = make(map[int32]committedOffset)
+				}
+				g.offsets[rt.Name][rp.PartitionIndex] = committedOffset{
+					offset:      rp.CommittedOffset,
+					leaderEpoch: rp.CommittedLeaderEpoch,
+					metadata:    rp.CommittedMetadata,
+				}
+			}
+			tr.Partitions = append(tr.Partitions, pr)
+		}
+		res.Topics = append(res.Topics, tr)
+	}
+	return res
+}
+
+func (b *Broker) offsetFetch(req *messages.OffsetFetchRequest) *messages.OffsetFetchResponse {
+	res := messages.NewOffsetFetchResponse(req.Version())
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// v8+ fetches the offsets of several groups at once
+	if req.Version() >= 8 {
+		for _, rg := range req.Groups {
+			topics := make([]messages.OffsetFetchRequestTopic, 0, len(rg.Topics))
+			for _, rt := range rg.Topics {
+				topics = append(topics, messages.OffsetFetchRequestTopic{Name: rt.Name, PartitionIndexes: rt.PartitionIndexes})
+			}
+			if rg.Topics == nil {
+				topics = nil
+			}
+
+			gr := messages.OffsetFetchResponseGroup{GroupId: rg.GroupId}
+			for _, tr := range b.committedOffsets(rg.GroupId, topics) {
+				ts := messages.OffsetFetchResponseTopics{Name: tr.Name}
+				for _, pr := range tr.Partitions {
+					ts.Partitions = append(ts.Partitions, messages.OffsetFetchResponsePartitions{
+						PartitionIndex:       pr.PartitionIndex,
+						CommittedOffset:      pr.CommittedOffset,
+						CommittedLeaderEpoch: pr.CommittedLeaderEpoch,
+						Metadata:             pr.Metadata,
+						ErrorCode:            pr.ErrorCode,
+					})
+				}
+				gr.Topics = append(gr.Topics, ts)
+			}
+			res.Groups = append(res.Groups, gr)
+		}
+		return res
+	}
+
+	res.Topics = b.committedOffsets(req.GroupId, req.Topics)
+	return res
+}
+
+// committedOffsets returns the offsets committed by a group for the given partitions, or for every
+// partition with a committed offset if topics is nil. Partitions without a committed offset have
+// offset -1.
+func (b *Broker) committedOffsets(groupId string, topics []messages.OffsetFetchRequestTopic) []messages.OffsetFetchResponseTopic {
+	var offsets map[string]map[int32]committedOffset
+	if g, ok := b.groups[groupId]; ok {
+		offsets = g.offsets
+	}
+
+	if topics == nil {
+		names := make([]string, 0, len(offsets))
+		for name := range offsets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			t := messages.OffsetFetchRequestTopic{Name: name}
+			for index := range offsets[name] {
+				t.PartitionIndexes = append(t.PartitionIndexes, index)
+			}
+			sort.Slice(t.PartitionIndexes, func(i, j int) bool {
+				return t.PartitionIndexes[i] < t.PartitionIndexes[j]
+			})
+			topics = append(topics, t)
+		}
+	}
+
+	var res []messages.OffsetFetchResponseTopic
+	for _, rt := range topics {
+		tr := messages.OffsetFetchResponseTopic{Name: rt.Name}
+		for _, index := range rt.PartitionIndexes {
+			var pr messages.OffsetFetchResponsePartition
+			pr.SetDefaults()
+			pr.PartitionIndex = index
+			pr.CommittedOffset = -1
+
+			metadata := ""
+			pr.Metadata = &metadata
+			if committed, ok := offsets[rt.Name][index]; ok {
+				pr.CommittedOffset = committed.offset
+				pr.CommittedLeaderEpoch = committed.leaderEpoch
+				if committed.metadata != nil {
+					pr.Metadata = committed.metadata
+				}
+			}
+			tr.Partitions = append(tr.Partitions, pr)
+		}
+		res = append(res, tr)
+	}
+	return res
+}
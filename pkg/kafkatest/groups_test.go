@@ -0,0 +1,127 @@
+package kafkatest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/client"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+)
+
+// joinGroup sends a JoinGroup v5 request for group "g" and returns its response.
+func joinGroup(t *testing.T, c *client.Conn, memberId string) *messages.JoinGroupResponse {
+	t.Helper()
+	req := messages.NewJoinGroupRequest(5)
+	req.GroupId, req.MemberId, req.ProtocolType = "g", memberId, "consumer"
+	req.SessionTimeoutMs, req.RebalanceTimeoutMs = 10000, 10000
+	req.Protocols = []messages.JoinGroupRequestProtocol{{Name: "range", Metadata: []byte(memberId)}}
+	res, err := c.RoundTrip(context.Background(), req)
+	if err != nil {
+		t.Error(err)
+		return messages.NewJoinGroupResponse(5)
+	}
+	return res.(*messages.JoinGroupResponse)
+}
+
+// newMember joins group "g" through MEMBER_ID_REQUIRED and returns the member id it was given.
+func newMember(t *testing.T, c *client.Conn) string {
+	t.Helper()
+	res := joinGroup(t, c, "")
+	if res.ErrorCode != int16(protocol.ErrMemberIdRequired) || res.MemberId == "" {
+		t.Fatalf("JoinGroup: error %d, member %q", res.ErrorCode, res.MemberId)
+	}
+	return res.MemberId
+}
+
+// goJoin sends a JoinGroup request from its own goroutine, as it waits for the rebalance.
+func goJoin(t *testing.T, c *client.Conn, memberId string) <-chan *messages.JoinGroupResponse {
+	ch := make(chan *messages.JoinGroupResponse, 1)
+	go func() { ch <- joinGroup(t, c, memberId) }()
+	return ch
+}
+
+func syncGroup(t *testing.T, c *client.Conn, memberId string, generation int32, assignments ...messages.SyncGroupRequestAssignment) *messages.SyncGroupResponse {
+	t.Helper()
+	req := messages.NewSyncGroupRequest(5)
+	req.GroupId, req.MemberId, req.GenerationId, req.Assignments = "g", memberId, generation, assignments
+	res, err := c.RoundTrip(context.Background(), req)
+	if err != nil {
+		t.Error(err)
+		return messages.NewSyncGroupResponse(5)
+	}
+	return res.(*messages.SyncGroupResponse)
+}
+
+// awaitRebalance waits until heartbeats of the member report that a rebalance is in progress.
+func awaitRebalance(t *testing.T, c *client.Conn, memberId string, generation int32) {
+	t.Helper()
+	req := messages.NewHeartbeatRequest(3)
+	req.GroupId, req.MemberId, req.GenerationId = "g", memberId, generation
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		res, err := c.RoundTrip(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.(*messages.HeartbeatResponse).ErrorCode == int16(protocol.ErrRebalanceInProgress) {
+			return
+		}
+	}
+	t.Fatal("no rebalance started")
+}
+
+func TestRebalance(t *testing.T) {
+	b := newBroker(t)
+	c1, c2, c3 := dial(t, b), dial(t, b), dial(t, b)
+
+	// the first member is alone in generation 1
+	first := newMember(t, c1)
+	res := joinGroup(t, c1, first)
+	if res.ErrorCode != 0 || res.GenerationId != 1 || res.Leader != first || len(res.Members) != 1 {
+		t.Fatalf("JoinGroup = %+v", res)
+	}
+	if res := syncGroup(t, c1, first, 1, messages.SyncGroupRequestAssignment{MemberId: first, Assignment: []byte("all")}); res.ErrorCode != 0 || string(res.Assignment) != "all" {
+		t.Fatalf("SyncGroup = %+v", res)
+	}
+
+	// the second member starts a rebalance, which completes when the first one rejoins
+	second := newMember(t, c2)
+	joined := goJoin(t, c2, second)
+	awaitRebalance(t, c1, first, 1)
+
+	// a duplicate join of the second member replaces the first request
+	replaced := joined
+	joined = goJoin(t, c3, second)
+	select {
+	case res := <-replaced:
+		if res.ErrorCode != int16(protocol.ErrRebalanceInProgress) {
+			t.Errorf("replaced JoinGroup: error %d", res.ErrorCode)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the replaced JoinGroup was not answered")
+	}
+
+	leader := joinGroup(t, c1, first)
+	follower := <-joined
+	if leader.ErrorCode != 0 || leader.GenerationId != 2 || leader.Leader != first || len(leader.Members) != 2 {
+		t.Fatalf("leader JoinGroup = %+v", leader)
+	}
+	if follower.ErrorCode != 0 || follower.GenerationId != 2 || follower.Leader != first || follower.MemberId != second || len(follower.Members) != 0 {
+		t.Fatalf("follower JoinGroup = %+v", follower)
+	}
+
+	// the follower waits for the assignments of the leader
+	synced := make(chan *messages.SyncGroupResponse, 1)
+	go func() { synced <- syncGroup(t, c2, second, 2) }()
+	res2 := syncGroup(t, c1, first, 2,
+		messages.SyncGroupRequestAssignment{MemberId: first, Assignment: []byte("0")},
+		messages.SyncGroupRequestAssignment{MemberId: second, Assignment: []byte("1")},
+	)
+	if res2.ErrorCode != 0 || string(res2.Assignment) != "0" {
+		t.Errorf("leader SyncGroup = %+v", res2)
+	}
+	if res := <-synced; res.ErrorCode != 0 || string(res.Assignment) != "1" || res.ProtocolName == nil || *res.ProtocolName != "range" {
+		t.Errorf("follower SyncGroup = %+v", res)
+	}
+}
@@ -0,0 +1,287 @@
+package kafkatest
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+)
+
+// TestReporter is the part of testing.TB that a MockBroker reports failures to.
+type TestReporter interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// MockBroker answers requests from expectations registered by a test. Requests that match no
+// expectation are reported to the test with a dump of the decoded request, and close their
+// connection. ApiVersions requests are answered with the versions supported by this library
+// unless an expectation matches them.
+//
+// The broker reports from its own goroutines, so it must be closed before the test ends.
+type MockBroker struct {
+	t      TestReporter
+	server *server
+
+	mu           sync.Mutex
+	expectations []*Expectation
+	versions     map[protocol.ApiKey]VersionRange
+}
+
+// VersionRange is a range of versions of an API advertised by a MockBroker or Broker.
+type VersionRange struct {
+	Min int
+	Max int
+}
+
+// NewMockBroker starts a mock broker that listens on a local port. Only the WithAddress and WithTLS
+// options apply to it.
+func NewMockBroker(t TestReporter, opts ...Option) (*MockBroker, error) {
+	o := newOptions(opts)
+	s, err := listen(o.address, o.tls)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &MockBroker{
+		t:        t,
+		server:   s,
+		versions: make(map[protocol.ApiKey]VersionRange),
+	}
+	s.decodeError = m.decodeError
+	s.start(m.handle)
+	return m, nil
+}
+
+// Addr returns the address that the broker listens on.
+func (m *MockBroker) Addr() string {
+	return m.server.Addr()
+}
+
+// Close stops the broker and closes every connection to it.
+func (m *MockBroker) Close() error {
+	return m.server.Close()
+}
+
+// SetVersions sets the versions of an API that the broker advertises in ApiVersions responses, so
+// that clients choose a particular version.
+func (m *MockBroker) SetVersions(key protocol.ApiKey, min, max int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.versions[key] = VersionRange{Min: min, Max: max}
+}
+
+// Expect registers an expectation for a request with the given API key. Requests are matched
+// against expectations in the order they were registered, skipping expectations that have been
+// met as many times as they allow. By default an expectation matches one request of any version
+// and answers it with an empty response.
+func (m *MockBroker) Expect(key protocol.ApiKey) *Expectation {
+	e := &Expectation{mock: m, key: key, version: -1, times: 1}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectations = append(m.expectations, e)
+	return e
+}
+
+// AssertExpectations reports every expectation that has not been met, and returns whether all of
+// them were.
+func (m *MockBroker) AssertExpectations() bool {
+	m.t.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ok := true
+	for _, e := range m.expectations {
+		if e.times >= 0 && e.calls < e.times {
+			m.t.Errorf("kafkatest: expectation not met: %v", e)
+			ok = false
+		}
+	}
+	return ok
+}
+
+func (m *MockBroker) handle(_ *connState, req protocol.Message) (protocol.Message, bool) {
+	m.mu.Lock()
+	var e *Expectation
+	for _, candidate := range m.expectations {
+		if candidate.matches(req) {
+			e = candidate
+			break
+		}
+	}
+	if e == nil {
+		defer m.mu.Unlock()
+		if req.ApiKey() == protocol.ApiVersions {
+			return m.apiVersions(req), true
+		}
+		m.t.Errorf("kafkatest: unexpected request %v v%d:\n%s", req.ApiKey(), req.Version(), Dump(req))
+		return nil, false
+	}
+	e.calls++
+	respond, desc := e.respond, e.String()
+	m.mu.Unlock()
+
+	var res protocol.Message
+	if respond != nil {
+		if res = respond(req); res == nil {
+			return nil, true
+		}
+	} else {
+		api, _ := protocol.Lookup(req.ApiKey())
+		res = api.NewResponse(req.Version())
+	}
+	if produce, ok := req.(*messages.ProduceRequest); ok && produce.Acks == 0 {
+		// clients do not read a response to a Produce request with acks set to 0
+		return nil, true
+	}
+
+	// the response is copied at the version of the request so that the same response can be
+	// answered on several connections at once
+	res, err := copyMessage(res, req.ApiKey(), req.Version())
+	if err != nil {
+		m.t.Errorf("kafkatest: response to %s: %v", desc, err)
+		return nil, false
+	}
+	res.SetCorrelationId(req.CorrelationId())
+	return res, true
+}
+
+func (m *MockBroker) apiVersions(req protocol.Message) protocol.Message {
+	res := messages.NewApiVersionsResponse(req.Version())
+	for _, api := range protocol.Apis() {
+		versions, ok := m.versions[api.Key]
+		if !ok {
+			versions = VersionRange{Min: api.MinVersion, Max: api.MaxVersion}
+		}
+		res.ApiKeys = append(res.ApiKeys, messages.ApiVersionsResponseApiVersion{
+			ApiKey:     int16(api.Key),
+			MinVersion: int16(versions.Min),
+			MaxVersion: int16(versions.Max),
+		})
+	}
+	res.SetCorrelationId(req.CorrelationId())
+	return res
+}
+
+func (m *MockBroker) decodeError(h *messages.RequestHeader, err error) {
+	if h == nil {
+		m.t.Errorf("kafkatest: undecodable request: %v", err)
+		return
+	}
+	m.t.Errorf("kafkatest: undecodable request %v v%d: %v", protocol.ApiKey(h.RequestApiKey), h.RequestApiVersion, err)
+}
+
+// copyMessage returns a copy of res at the given version, by encoding and decoding it.
+func copyMessage(res protocol.Message, key protocol.ApiKey, version int) (protocol.Message, error) {
+	if res.ApiKey() != key {
+		return nil, fmt.Errorf("response has API key %v", res.ApiKey())
+	}
+	api, ok := protocol.Lookup(key)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", protocol.ErrUnknownApiKey, key)
+	}
+
+	// generated messages are pointers to structs, and a shallow copy is enough to encode res at
+	// another version without changing it
+	copied := reflect.New(reflect.TypeOf(res).Elem())
+	copied.Elem().Set(reflect.ValueOf(res).Elem())
+	encoded := copied.Interface().(protocol.Message)
+	encoded.SetVersion(version)
+
+	var buf bytes.Buffer
+	if err := encoded.Encode(protocol.NewMessageWriter(&buf)); err != nil {
+		return nil, err
+	}
+	decoded := api.NewResponse(version)
+	if err := decoded.Unmarshal(buf.Bytes()); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// Expectation is a request that a MockBroker expects to receive, and how to answer it. Its
+// methods return the expectation so that they can be chained.
+type Expectation struct {
+	mock    *MockBroker
+	key     protocol.ApiKey
+	version int
+	match   func(protocol.Message) bool
+	respond func(protocol.Message) protocol.Message
+	times   int
+	calls   int
+}
+
+// Version restricts the expectation to requests of the given version.
+func (e *Expectation) Version(version int) *Expectation {
+	e.mock.mu.Lock()
+	defer e.mock.mu.Unlock()
+	e.version = version
+	return e
+}
+
+// Match restricts the expectation to requests for which fn returns true. The request has the
+// generated type of its API key, such as *messages.FetchRequest.
+func (e *Expectation) Match(fn func(req protocol.Message) bool) *Expectation {
+	e.mock.mu.Lock()
+	defer e.mock.mu.Unlock()
+	e.match = fn
+	return e
+}
+
+// Respond answers matching requests with res, at the version of the request.
+func (e *Expectation) Respond(res protocol.Message) *Expectation {
+	return e.RespondWith(func(protocol.Message) protocol.Message {
+		return res
+	})
+}
+
+// RespondWith answers matching requests with the response returned by fn, at the version of the
+// request. If fn returns nil the request is not answered. Produce requests with acks set to 0 are
+// never answered.
+func (e *Expectation) RespondWith(fn func(req protocol.Message) protocol.Message) *Expectation {
+	e.mock.mu.Lock()
+	defer e.mock.mu.Unlock()
+	e.respond = fn
+	return e
+}
+
+// Times sets the number of requests that the expectation matches.
+func (e *Expectation) Times(n int) *Expectation {
+	e.mock.mu.Lock()
+	defer e.mock.mu.Unlock()
+	e.times = n
+	return e
+}
+
+// AnyTimes lets the expectation match any number of requests, including none.
+func (e *Expectation) AnyTimes() *Expectation {
+	return e.Times(-1)
+}
+
+func (e *Expectation) matches(req protocol.Message) bool {
+	return req.ApiKey() == e.key &&
+		(e.version < 0 || req.Version() == e.version) &&
+		(e.times < 0 || e.calls < e.times) &&
+		(e.match == nil || e.match(req))
+}
+
+func (e *Expectation) String() string {
+	var b strings.Builder
+	b.WriteString(e.key.String())
+	if e.version >= 0 {
+		fmt.Fprintf(&b, " v%d", e.version)
+	}
+	if e.match != nil {
+		b.WriteString(" matching a predicate")
+	}
+	if e.times >= 0 {
+		fmt.Fprintf(&b, " (%d of %d calls)", e.calls, e.times)
+	} else {
+		fmt.Fprintf(&b, " (%d calls)", e.calls)
+	}
+	return b.String()
+}
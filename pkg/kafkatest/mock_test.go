@@ -0,0 +1,70 @@
+package kafkatest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/client"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+)
+
+func dialMock(t *testing.T, m *MockBroker, opts ...client.Option) *client.Conn {
+	t.Helper()
+	c, err := client.Dial(m.Addr(), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestMockBroker(t *testing.T) {
+	m, err := NewMockBroker(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	res := messages.NewFindCoordinatorResponse(0)
+	res.Host = "coordinator"
+	m.Expect(protocol.FindCoordinator).Version(4).Respond(res)
+	m.SetVersions(protocol.FindCoordinator, 0, 4)
+
+	c := dialMock(t, m)
+	got, err := c.RoundTrip(context.Background(), messages.NewFindCoordinatorRequest(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Version() != 4 {
+		t.Errorf("response v%d, want v4", got.Version())
+	}
+	m.AssertExpectations()
+}
+
+func TestMockBrokerProduceWithoutAcks(t *testing.T) {
+	m, err := NewMockBroker(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	// neither the default response nor an explicit one is sent for acks set to 0
+	m.Expect(protocol.Produce)
+	m.Expect(protocol.Produce).Respond(messages.NewProduceResponse(0))
+	m.Expect(protocol.Metadata)
+
+	c := dialMock(t, m)
+	for range 2 {
+		req := messages.NewProduceRequest(3)
+		req.Acks = 0
+		if res, err := c.RoundTrip(context.Background(), req); res != nil || err != nil {
+			t.Fatalf("RoundTrip() = %v, %v, want no response", res, err)
+		}
+	}
+	// the connection is still in sync with the broker
+	if _, err := c.RoundTrip(context.Background(), messages.NewMetadataRequest(0)); err != nil {
+		t.Fatal(err)
+	}
+	m.AssertExpectations()
+}
@@ -0,0 +1,109 @@
+package kafkatest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+	"github.com/ethanmoffat/kafka-protocol/pkg/sasl"
+)
+
+// saslMechanisms returns the mechanisms that clients authenticate with, or none if the broker
+// does not require authentication.
+func (b *Broker) saslMechanisms() []sasl.ServerMechanism {
+	var mechanisms []sasl.ServerMechanism
+	if b.options.scram {
+		mechanisms = b.scramMechanisms()
+	}
+	return append(mechanisms, b.options.mechanisms...)
+}
+
+// authenticated reports whether a connection may send requests other than the ones that
+// authenticate it.
+func (b *Broker) authenticated(c *connState) bool {
+	if len(b.saslMechanisms()) == 0 {
+		return true
+	}
+	return c.user != "" && (c.expires.IsZero() || time.Now().Before(c.expires))
+}
+
+func (b *Broker) saslHandshake(c *connState, req *messages.SaslHandshakeRequest) *messages.SaslHandshakeResponse {
+	res := messages.NewSaslHandshakeResponse(req.Version())
+	var mechanism sasl.ServerMechanism
+	for _, m := range b.saslMechanisms() {
+		res.Mechanisms = append(res.Mechanisms, m.Name())
+		if m.Name() == req.Mechanism {
+			mechanism = m
+		}
+	}
+
+	switch {
+	case mechanism == nil:
+		res.ErrorCode = int16(protocol.ErrUnsupportedSaslMechanism)
+	case c.sasl != nil:
+		res.ErrorCode = int16(protocol.ErrIllegalSaslState)
+	case c.user != "" && (req.Version() == 0 || req.Mechanism != c.mechanism):
+		// re-authentication (KIP-368) uses SaslAuthenticate requests and the same mechanism
+		res.ErrorCode = int16(protocol.ErrIllegalSaslState)
+	default:
+		session, err := mechanism.Start(context.Background())
+		if err != nil {
+			res.ErrorCode = int16(protocol.ErrUnsupportedSaslMechanism)
+			break
+		}
+		c.sasl = session
+		c.mechanism = req.Mechanism
+		c.unframed = req.Version() == 0
+	}
+	return res
+}
+
+// saslToken answers a SASL token sent without a request header after a v0 SaslHandshake. A failed
+// exchange closes the connection, as there is no response to report the error in.
+func (b *Broker) saslToken(c *connState, token []byte) ([]byte, bool) {
+	done, challenge, err := c.sasl.Next(context.Background(), token)
+	if err != nil {
+		return nil, false
+	}
+	if done {
+		c.user = c.sasl.Username()
+		c.sasl = nil
+		c.unframed = false
+	}
+	return challenge, true
+}
+
+func (b *Broker) saslAuthenticate(c *connState, req *messages.SaslAuthenticateRequest) *messages.SaslAuthenticateResponse {
+	res := messages.NewSaslAuthenticateResponse(req.Version())
+	if c.sasl == nil {
+		message := "SaslAuthenticate request received before SaslHandshake"
+		res.ErrorCode, res.ErrorMessage = int16(protocol.ErrIllegalSaslState), &message
+		return res
+	}
+
+	done, challenge, err := c.sasl.Next(context.Background(), req.AuthBytes)
+	if err == nil && done && c.user != "" && c.sasl.Username() != c.user {
+		err = fmt.Errorf("cannot change principals during re-authentication from %s to %s", c.user, c.sasl.Username())
+	}
+	if err != nil {
+		// brokers close connections that fail to authenticate once the response is sent, and
+		// requests that need authentication close this one
+		c.sasl, c.user, c.expires = nil, "", time.Time{}
+		message := err.Error()
+		res.ErrorCode, res.ErrorMessage = int16(protocol.ErrSaslAuthenticationFailed), &message
+		return res
+	}
+	res.AuthBytes = challenge
+	if done {
+		c.user = c.sasl.Username()
+		c.sasl = nil
+		c.expires = time.Time{}
+		if lifetime := b.options.sessionLifetime; lifetime > 0 && req.Version() >= 1 {
+			c.expires = time.Now().Add(lifetime)
+			res.SessionLifetimeMs = lifetime.Milliseconds()
+		}
+	}
+	return res
+}
@@ -0,0 +1,201 @@
+package kafkatest
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sort"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+	"github.com/ethanmoffat/kafka-protocol/pkg/sasl"
+)
+
+// maxScramIterations is the largest iteration count that Kafka accepts for SCRAM credentials.
+const maxScramIterations = 16384
+
+// SetScramCredential sets the password of a user for a SCRAM mechanism.
+func (b *Broker) SetScramCredential(user string, mechanism sasl.ScramMechanism, password string) error {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	credential, err := sasl.NewScramCredential(mechanism, password, salt, sasl.MinScramIterations)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.setScramCredential(user, credential)
+	return nil
+}
+
+func (b *Broker) setScramCredential(user string, credential sasl.ScramCredential) {
+	if b.scram[user] == nil {
+		b.scram[user] = make(map[sasl.ScramMechanism]sasl.ScramCredential)
+	}
+	b.scram[user][credential.Mechanism] = credential
+}
+
+func (b *Broker) scramCredential(user string, mechanism sasl.ScramMechanism) (sasl.ScramCredential, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	credential, ok := b.scram[user][mechanism]
+	return credential, ok
+}
+
+// scramMechanisms returns the SCRAM mechanisms of the broker, which verify clients against the
+// credentials of the broker.
+func (b *Broker) scramMechanisms() []sasl.ServerMechanism {
+	var mechanisms []sasl.ServerMechanism
+	for _, mechanism := range []sasl.ScramMechanism{sasl.ScramSha256, sasl.ScramSha512} {
+		mechanisms = append(mechanisms, sasl.ScramServer{
+			Mechanism: mechanism,
+			Credentials: func(user string) (sasl.ScramCredential, bool) {
+				return b.scramCredential(user, mechanism)
+			},
+		})
+	}
+	return mechanisms
+}
+
+func (b *Broker) describeUserScramCredentials(req *messages.DescribeUserScramCredentialsRequest) *messages.DescribeUserScramCredentialsResponse {
+	res := messages.NewDescribeUserScramCredentialsResponse(req.Version())
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// a null or empty list describes every user with credentials
+	var users []string
+	for _, u := range req.Users {
+		users = append(users, u.Name)
+	}
+	if len(users) == 0 {
+		for user := range b.scram {
+			users = append(users, user)
+		}
+		sort.Strings(users)
+	}
+
+	requested := make(map[string]int)
+	for _, user := range users {
+		requested[user]++
+	}
+	for _, user := range users {
+		result := messages.DescribeUserScramCredentialsResponseDescribeUserScramCredentialsResult{User: user}
+		switch {
+		case requested[user] > 1:
+			message := "Cannot describe SCRAM credentials for the same user twice in a single request: " + user
+			result.ErrorCode, result.ErrorMessage = int16(protocol.ErrDuplicateResource), &message
+		case len(b.scram[user]) == 0:
+			message := "Attempt to describe nonexistent credential for user " + user
+			result.ErrorCode, result.ErrorMessage = int16(protocol.ErrResourceNotFound), &message
+		default:
+			for _, mechanism := range []sasl.ScramMechanism{sasl.ScramSha256, sasl.ScramSha512} {
+				if credential, ok := b.scram[user][mechanism]; ok {
+					result.CredentialInfos = append(result.CredentialInfos, messages.DescribeUserScramCredentialsResponseCredentialInfo{
+						Mechanism:  int8(mechanism),
+						Iterations: int32(credential.Iterations),
+					})
+				}
+			}
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res
+}
+
+// scramAlteration is a deletion or upsertion of an AlterUserScramCredentials request.
+type scramAlteration struct {
+	mechanism sasl.ScramMechanism
+	// upsertion is nil for deletions.
+	upsertion *messages.AlterUserScramCredentialsRequestScramCredentialUpsertion
+}
+
+func (b *Broker) alterUserScramCredentials(req *messages.AlterUserScramCredentialsRequest) *messages.AlterUserScramCredentialsResponse {
+	res := messages.NewAlterUserScramCredentialsResponse(req.Version())
+
+	// alterations are grouped by user, and those of a user are only applied if all of them are valid
+	var users []string
+	alterations := make(map[string][]scramAlteration)
+	add := func(user string, a scramAlteration) {
+		if _, ok := alterations[user]; !ok {
+			users = append(users, user)
+		}
+		alterations[user] = append(alterations[user], a)
+	}
+	for _, d := range req.Deletions {
+		add(d.Name, scramAlteration{mechanism: sasl.ScramMechanism(d.Mechanism)})
+	}
+	for i := range req.Upsertions {
+		u := &req.Upsertions[i]
+		add(u.Name, scramAlteration{mechanism: sasl.ScramMechanism(u.Mechanism), upsertion: u})
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, user := range users {
+		result := messages.AlterUserScramCredentialsResponseAlterUserScramCredentialsResult{User: user}
+		credentials, code, message := b.checkScramAlterations(user, alterations[user])
+		if code != protocol.NoError {
+			result.ErrorCode, result.ErrorMessage = int16(code), &message
+			res.Results = append(res.Results, result)
+			continue
+		}
+
+		for _, a := range alterations[user] {
+			if a.upsertion == nil {
+				delete(b.scram[user], a.mechanism)
+			}
+		}
+		for _, credential := range credentials {
+			b.setScramCredential(user, credential)
+		}
+		if len(b.scram[user]) == 0 {
+			delete(b.scram, user)
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res
+}
+
+// checkScramAlterations validates the alterations of a user, and returns the credentials that they
+// upsert.
+func (b *Broker) checkScramAlterations(user string, alterations []scramAlteration) ([]sasl.ScramCredential, protocol.ErrorCode, string) {
+	if user == "" {
+		return nil, protocol.ErrUnacceptableCredential, "Username must not be empty"
+	}
+
+	var credentials []sasl.ScramCredential
+	altered := make(map[sasl.ScramMechanism]bool)
+	for _, a := range alterations {
+		if a.mechanism != sasl.ScramSha256 && a.mechanism != sasl.ScramSha512 {
+			return nil, protocol.ErrUnsupportedSaslMechanism, fmt.Sprintf("Unknown SCRAM mechanism %d", a.mechanism)
+		}
+		if altered[a.mechanism] {
+			return nil, protocol.ErrDuplicateResource, "A user credential cannot be altered twice in the same request"
+		}
+		altered[a.mechanism] = true
+
+		u := a.upsertion
+		switch {
+		case u == nil:
+			if _, ok := b.scram[user][a.mechanism]; !ok {
+				return nil, protocol.ErrResourceNotFound, "Attempt to delete a user credential that does not exist"
+			}
+			continue
+		case u.Iterations < sasl.MinScramIterations:
+			return nil, protocol.ErrUnacceptableCredential, fmt.Sprintf("Too few iterations: %d", u.Iterations)
+		case u.Iterations > maxScramIterations:
+			return nil, protocol.ErrUnacceptableCredential, fmt.Sprintf("Too many iterations: %d", u.Iterations)
+		case len(u.Salt) == 0 || len(u.SaltedPassword) == 0:
+			return nil, protocol.ErrUnacceptableCredential, "Salt and salted password must not be empty"
+		}
+		credential, err := sasl.ScramCredentialFromSaltedPassword(a.mechanism, u.Salt, u.SaltedPassword, int(u.Iterations))
+		if err != nil {
+			return nil, protocol.ErrUnacceptableCredential, err.Error()
+		}
+		credentials = append(credentials, credential)
+	}
+	return credentials, protocol.NoError, ""
+}
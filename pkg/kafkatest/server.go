@@ -0,0 +1,198 @@
+package kafkatest
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+	"github.com/ethanmoffat/kafka-protocol/pkg/sasl"
+)
+
+// handler answers a request. The response is nil for requests that get no response, and ok is
+// false for requests that cannot be answered, which closes the connection.
+type handler func(c *connState, req protocol.Message) (res protocol.Message, ok bool)
+
+// connState is the state of a connection that spans requests.
+type connState struct {
+	// sasl is the authentication exchange in progress, if any.
+	sasl sasl.ServerSession
+	// unframed is set while the exchange follows a v0 SaslHandshake, whose SASL tokens are sent
+	// in frames without a request header.
+	unframed bool
+	// mechanism and user are set once the connection has authenticated.
+	mechanism string
+	user      string
+	// expires is when the session of the user expires, or zero if it does not.
+	expires time.Time
+}
+
+// server accepts connections and answers their requests in order, as a broker does.
+type server struct {
+	listener net.Listener
+	host     string
+	port     int32
+
+	handle handler
+	// handleSasl answers an unframed SASL token. It returns false to close the connection.
+	handleSasl func(c *connState, token []byte) (challenge []byte, ok bool)
+	// decodeError is called with requests that cannot be decoded before their connection is closed.
+	decodeError func(h *messages.RequestHeader, err error)
+
+	mu     sync.Mutex
+	conns  map[net.Conn]struct{}
+	closed chan struct{}
+	wg     sync.WaitGroup
+}
+
+// listen listens on address, over TLS if config is set.
+func listen(address string, config *tls.Config) (*server, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	if config != nil {
+		listener = tls.NewListener(listener, config)
+	}
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return &server{
+		listener: listener,
+		host:     host,
+		port:     int32(p),
+		conns:    make(map[net.Conn]struct{}),
+		closed:   make(chan struct{}),
+	}, nil
+}
+
+// start accepts connections until the server is closed.
+func (s *server) start(handle handler) {
+	s.handle = handle
+	s.wg.Add(1)
+	go s.serve()
+}
+
+// Addr returns the address that the server listens on.
+func (s *server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Close stops the server and closes every connection to it.
+func (s *server) Close() error {
+	s.mu.Lock()
+	select {
+	case <-s.closed:
+		s.mu.Unlock()
+		return nil
+	default:
+	}
+	close(s.closed)
+	err := s.listener.Close()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return err
+}
+
+func (s *server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		select {
+		case <-s.closed:
+			s.mu.Unlock()
+			conn.Close()
+			return
+		default:
+		}
+		s.conns[conn] = struct{}{}
+		s.wg.Add(1)
+		s.mu.Unlock()
+
+		go s.serveConn(conn)
+	}
+}
+
+// serveConn answers the requests of a connection in order until it fails or sends a request that
+// cannot be answered.
+func (s *server) serveConn(conn net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+		conn.Close()
+	}()
+
+	reader := protocol.NewFrameReader(conn, protocol.DefaultMaxFrameSize)
+	writer := protocol.NewFrameWriter(conn)
+	state := new(connState)
+	for {
+		frame, err := reader.ReadFrame()
+		if err != nil {
+			return
+		}
+		if state.unframed && s.handleSasl != nil {
+			challenge, ok := s.handleSasl(state, frame)
+			if !ok || writer.WriteFrame(nil, challenge) != nil {
+				return
+			}
+			continue
+		}
+
+		h, req, err := messages.DecodeRequest(frame)
+		if h != nil && protocol.ApiKey(h.RequestApiKey) == protocol.ApiVersions && errors.Is(err, protocol.ErrVersionNotImplemented) {
+			// clients retry with a version from the v0 response, which lists what is supported
+			req, err = messages.NewApiVersionsRequest(0), nil
+			req.SetCorrelationId(int(h.CorrelationId))
+		}
+		if err != nil {
+			if s.decodeError != nil {
+				s.decodeError(h, err)
+			}
+			return
+		}
+
+		res, ok := s.handle(state, req)
+		if !ok {
+			return
+		}
+		if res == nil {
+			continue
+		}
+		if versions, ok := res.(*messages.ApiVersionsResponse); ok && int(h.RequestApiVersion) != req.Version() {
+			versions.ErrorCode = int16(protocol.ErrUnsupportedVersion)
+		}
+
+		var body bytes.Buffer
+		if err := messages.EncodeResponse(protocol.NewMessageWriter(&body), res); err != nil {
+			return
+		}
+		if err := writer.WriteFrame(nil, body.Bytes()); err != nil {
+			return
+		}
+	}
+}
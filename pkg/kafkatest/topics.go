@@ -0,0 +1,486 @@
+package kafkatest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/compress"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+)
+
+type topic struct {
+	name       string
+	id         uuid.UUID
+	partitions []*partition
+}
+
+// partition is the log of a partition. Offsets start at 0 and are never deleted.
+type partition struct {
+	entries []entry
+	next    int64
+}
+
+// entry is a record batch or legacy message that was appended to a partition.
+type entry struct {
+	set  protocol.RecordSet
+	data []byte
+	// records holds the offset and timestamp of each record of the entry.
+	records []recordIndex
+	last    int64
+}
+
+type recordIndex struct {
+	offset    int64
+	timestamp int64
+}
+
+// CreateTopic creates a topic with the given number of partitions.
+func (b *Broker) CreateTopic(name string, partitions int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.topics[name]; ok {
+		return fmt.Errorf("%w: %s", protocol.ErrTopicAlreadyExists, name)
+	}
+	if partitions < 1 {
+		return fmt.Errorf("%w: %d", protocol.ErrInvalidPartitions, partitions)
+	}
+	b.createTopic(name, partitions)
+	return nil
+}
+
+func (b *Broker) createTopic(name string, partitions int) *topic {
+	t := &topic{name: name, id: uuid.New()}
+	for i := 0; i < partitions; i++ {
+		t.partitions = append(t.partitions, new(partition))
+	}
+	b.topics[name] = t
+	return t
+}
+
+// Records returns the record batches and legacy messages appended to a partition, with the offsets
+// assigned by the broker. They must not be modified.
+func (b *Broker) Records(topic string, partition int32) ([]protocol.RecordSet, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	p := b.partition(topic, partition)
+	if p == nil {
+		return nil, fmt.Errorf("%w: %s-%d", protocol.ErrUnknownTopicOrPartition, topic, partition)
+	}
+
+	sets := make([]protocol.RecordSet, len(p.entries))
+	for i, e := range p.entries {
+		sets[i] = e.set
+	}
+	return sets, nil
+}
+
+func (b *Broker) partition(topic string, index int32) *partition {
+	if t, ok := b.topics[topic]; ok {
+		return t.partition(index)
+	}
+	return nil
+}
+
+func (b *Broker) topicById(id uuid.UUID) *topic {
+	for _, t := range b.topics {
+		if t.id == id {
+			return t
+		}
+	}
+	return nil
+}
+
+func (t *topic) partition(index int32) *partition {
+	if t == nil || index < 0 || int(index) >= len(t.partitions) {
+		return nil
+	}
+	return t.partitions[index]
+}
+
+// append assigns offsets to the entries of a records field and appends them to the log. It returns
+// the offset of the first entry. Nothing is appended if the records cannot be decoded.
+func (p *partition) append(data []byte, opts ...protocol.DecodeOption) (int64, error) {
+	sets, err := protocol.DecodeRecords(data, opts...)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, fmt.Errorf("%w: no records", protocol.ErrCorruptRecords)
+	}
+
+	next := p.next
+	entries := make([]entry, 0, len(sets))
+	for _, set := range sets {
+		var e entry
+		switch s := set.(type) {
+		case *protocol.RecordBatch:
+			s.BaseOffset = next
+			for _, rec := range s.Records {
+				timestamp := s.BaseTimestamp + rec.TimestampDelta
+				if s.LogAppendTime() {
+					timestamp = s.MaxTimestamp
+				}
+				e.records = append(e.records, recordIndex{next + int64(rec.OffsetDelta), timestamp})
+			}
+			next += int64(s.LastOffsetDelta) + 1
+		case *protocol.LegacyMessage:
+			s.Offset = next
+			timestamp := int64(-1)
+			if s.MagicByte == 1 {
+				timestamp = s.Timestamp
+			}
+			e.records = append(e.records, recordIndex{next, timestamp})
+			next++
+		}
+
+		var buf bytes.Buffer
+		if err := set.Encode(protocol.NewMessageWriter(&buf)); err != nil {
+			return 0, err
+		}
+		e.set, e.data, e.last = set, buf.Bytes(), next-1
+		entries = append(entries, e)
+	}
+
+	base := p.next
+	p.entries = append(p.entries, entries...)
+	p.next = next
+	return base, nil
+}
+
+// read returns the entries from the one that holds offset, converted for the given version of
+// Fetch, while they fit in maxBytes. At least one entry is returned if first is set.
+func (p *partition) read(offset int64, maxBytes int, version int, first bool) ([]byte, error) {
+	// record batches were introduced by Fetch v4 and timestamps by v2
+	magic := int8(2)
+	switch {
+	case version < 2:
+		magic = 0
+	case version < 4:
+		magic = 1
+	}
+
+	i := sort.Search(len(p.entries), func(i int) bool {
+		return p.entries[i].last >= offset
+	})
+
+	var buf bytes.Buffer
+	for ; i < len(p.entries); i++ {
+		if b, ok := p.entries[i].set.(*protocol.RecordBatch); ok {
+			if err := protocol.CheckCompression(protocol.Fetch, version, compress.Id(b.Compression())); err != nil {
+				return nil, err
+			}
+		}
+		data, err := p.entries[i].convert(magic)
+		if err != nil {
+			return nil, err
+		}
+		if buf.Len()+len(data) > maxBytes && !(first && buf.Len() == 0) {
+			break
+		}
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
+
+// convert returns the entry encoded with the given magic, for fetch versions that predate it.
+func (e *entry) convert(magic int8) ([]byte, error) {
+	if e.set.Magic() <= magic {
+		return e.data, nil
+	}
+
+	var converted []*protocol.LegacyMessage
+	switch s := e.set.(type) {
+	case *protocol.RecordBatch:
+		messages, err := s.DownConvert(magic)
+		if err != nil {
+			return nil, err
+		}
+		converted = messages
+	case *protocol.LegacyMessage:
+		m := *s
+		// magic v0 has neither a timestamp nor the timestamp type attribute
+		m.MagicByte, m.Timestamp = magic, 0
+		m.Attributes &^= 0x08
+		converted = append(converted, &m)
+	}
+
+	var buf bytes.Buffer
+	w := protocol.NewMessageWriter(&buf)
+	for _, m := range converted {
+		if err := m.Encode(w); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// offsetForTimestamp returns the offset and timestamp of the first record with a timestamp at or
+// after timestamp, or of the record with the largest timestamp for -3. It returns -1 for both if
+// there is no such record.
+func (p *partition) offsetForTimestamp(timestamp int64) (int64, int64) {
+	offset, found := int64(-1), int64(-1)
+	for _, e := range p.entries {
+		for _, rec := range e.records {
+			switch {
+			case timestamp == -3:
+				if rec.timestamp > found {
+					offset, found = rec.offset, rec.timestamp
+				}
+			case rec.timestamp >= timestamp:
+				return rec.offset, rec.timestamp
+			}
+		}
+	}
+	return offset, found
+}
+
+func (b *Broker) metadata(req *messages.MetadataRequest) *messages.MetadataResponse {
+	res := messages.NewMetadataResponse(req.Version())
+	clusterId := b.options.clusterId
+	res.ClusterId = &clusterId
+	res.ControllerId = b.options.nodeId
+	res.Brokers = []messages.MetadataResponseBroker{{
+		NodeId: b.options.nodeId,
+		Host:   b.server.host,
+		Port:   b.server.port,
+	}}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// v0 requests all topics with an empty list, and later versions with a null one
+	if req.Topics == nil || (req.Version() == 0 && len(req.Topics) == 0) {
+		names := make([]string, 0, len(b.topics))
+		for name := range b.topics {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			res.Topics = append(res.Topics, b.topicMetadata(b.topics[name]))
+		}
+		return res
+	}
+
+	autoCreate := b.options.autoCreate && (req.Version() < 4 || req.AllowAutoTopicCreation)
+	for _, rt := range req.Topics {
+		var t *topic
+		if rt.Name != nil {
+			t = b.topics[*rt.Name]
+			if t == nil && autoCreate {
+				t = b.createTopic(*rt.Name, b.options.autoPartitions)
+			}
+		} else {
+			t = b.topicById(rt.TopicId)
+		}
+		if t != nil {
+			res.Topics = append(res.Topics, b.topicMetadata(t))
+			continue
+		}
+
+		var tm messages.MetadataResponseTopic
+		tm.SetDefaults()
+		tm.Name, tm.TopicId = rt.Name, rt.TopicId
+		tm.ErrorCode = int16(protocol.ErrUnknownTopicOrPartition)
+		if rt.Name == nil {
+			tm.ErrorCode = int16(protocol.ErrUnknownTopicId)
+		}
+		res.Topics = append(res.Topics, tm)
+	}
+	return res
+}
+
+func (b *Broker) topicMetadata(t *topic) messages.MetadataResponseTopic {
+	var tm messages.MetadataResponseTopic
+	tm.SetDefaults()
+	name := t.name
+	tm.Name, tm.TopicId = &name, t.id
+
+	nodes := []int32{b.options.nodeId}
+	for i := range t.partitions {
+		var pm messages.MetadataResponsePartition
+		pm.SetDefaults()
+		pm.PartitionIndex = int32(i)
+		pm.LeaderId = b.options.nodeId
+		pm.LeaderEpoch = 0
+		pm.ReplicaNodes, pm.IsrNodes = nodes, nodes
+		tm.Partitions = append(tm.Partitions, pm)
+	}
+	return tm
+}
+
+func (b *Broker) produce(req *messages.ProduceRequest) *messages.ProduceResponse {
+	res := messages.NewProduceResponse(req.Version())
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	appended := false
+	for _, td := range req.TopicData {
+		tr := messages.ProduceResponseTopicProduceResponse{Name: td.Name}
+		for _, pd := range td.PartitionData {
+			var pr messages.ProduceResponsePartitionProduceResponse
+			pr.SetDefaults()
+			pr.Index = pd.Index
+			pr.BaseOffset = -1
+
+			p := b.partition(td.Name, pd.Index)
+			if p == nil {
+				pr.ErrorCode = int16(protocol.ErrUnknownTopicOrPartition)
+				tr.PartitionResponses = append(tr.PartitionResponses, pr)
+				continue
+			}
+
+			base, err := p.append(pd.Records, protocol.WithApiVersion(protocol.Produce, req.Version()))
+			switch {
+			case errors.Is(err, protocol.ErrUnsupportedCompression):
+				pr.ErrorCode = int16(protocol.ErrUnsupportedCompressionType)
+			case err != nil:
+				pr.ErrorCode = int16(protocol.ErrCorruptMessage)
+			default:
+				pr.BaseOffset, pr.LogStartOffset = base, 0
+				appended = true
+			}
+			tr.PartitionResponses = append(tr.PartitionResponses, pr)
+		}
+		res.Responses = append(res.Responses, tr)
+	}
+
+	if appended {
+		// wake fetches that are waiting for data
+		close(b.appended)
+		b.appended = make(chan struct{})
+	}
+	return res
+}
+
+// fetch answers a fetch request, waiting up to its maximum wait time for MinBytes of records.
+// Fetch sessions are not supported, so every response is a full response.
+func (b *Broker) fetch(req *messages.FetchRequest) *messages.FetchResponse {
+	timer := time.NewTimer(time.Duration(req.MaxWaitMs) * time.Millisecond)
+	defer timer.Stop()
+	for {
+		b.mu.Lock()
+		res, size := b.readFetch(req)
+		appended := b.appended
+		b.mu.Unlock()
+		if size >= int(req.MinBytes) || req.MaxWaitMs <= 0 {
+			return res
+		}
+
+		select {
+		case <-appended:
+		case <-timer.C:
+			return res
+		case <-b.server.closed:
+			return res
+		}
+	}
+}
+
+// readFetch builds the response to req from the current logs. It returns the response and the
+// number of bytes of records in it.
+func (b *Broker) readFetch(req *messages.FetchRequest) (*messages.FetchResponse, int) {
+	version := req.Version()
+	res := messages.NewFetchResponse(version)
+
+	maxBytes := int(req.MaxBytes)
+	if version < 3 || maxBytes <= 0 {
+		maxBytes = protocol.DefaultMaxFrameSize
+	}
+
+	size := 0
+	for _, ft := range req.Topics {
+		t := b.topics[ft.Topic]
+		if version >= 13 {
+			t = b.topicById(ft.TopicId)
+		}
+		tr := messages.FetchResponseFetchableTopicResponse{Topic: ft.Topic, TopicId: ft.TopicId}
+		for _, fp := range ft.Partitions {
+			var pr messages.FetchResponsePartitionData
+			pr.SetDefaults()
+			pr.PartitionIndex = fp.Partition
+			pr.HighWatermark = -1
+
+			p := t.partition(fp.Partition)
+			switch {
+			case t == nil && version >= 13:
+				pr.ErrorCode = int16(protocol.ErrUnknownTopicId)
+			case p == nil:
+				pr.ErrorCode = int16(protocol.ErrUnknownTopicOrPartition)
+			case fp.FetchOffset < 0 || fp.FetchOffset > p.next:
+				pr.ErrorCode = int16(protocol.ErrOffsetOutOfRange)
+				pr.HighWatermark, pr.LastStableOffset, pr.LogStartOffset = p.next, p.next, 0
+			default:
+				pr.HighWatermark, pr.LastStableOffset, pr.LogStartOffset = p.next, p.next, 0
+				limit := maxBytes - size
+				if version >= 3 && int(fp.PartitionMaxBytes) < limit {
+					limit = int(fp.PartitionMaxBytes)
+				}
+				records, err := p.read(fp.FetchOffset, limit, version, size == 0)
+				if errors.Is(err, protocol.ErrUnsupportedCompression) {
+					pr.ErrorCode = int16(protocol.ErrUnsupportedCompressionType)
+					break
+				}
+				if err != nil {
+					pr.ErrorCode = int16(protocol.ErrUnknownServerError)
+					break
+				}
+				pr.Records = records
+				size += len(records)
+			}
+			tr.Partitions = append(tr.Partitions, pr)
+		}
+		res.Responses = append(res.Responses, tr)
+	}
+	return res, size
+}
+
+func (b *Broker) listOffsets(req *messages.ListOffsetsRequest) *messages.ListOffsetsResponse {
+	res := messages.NewListOffsetsResponse(req.Version())
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, rt := range req.Topics {
+		tr := messages.ListOffsetsResponseListOffsetsTopicResponse{Name: rt.Name}
+		for _, rp := range rt.Partitions {
+			var pr messages.ListOffsetsResponseListOffsetsPartitionResponse
+			pr.SetDefaults()
+			pr.PartitionIndex = rp.PartitionIndex
+
+			p := b.partition(rt.Name, rp.PartitionIndex)
+			if p == nil {
+				pr.ErrorCode = int16(protocol.ErrUnknownTopicOrPartition)
+				tr.Partitions = append(tr.Partitions, pr)
+				continue
+			}
+
+			offset, timestamp := int64(-1), int64(-1)
+			switch rp.Timestamp {
+			case -1:
+				offset = p.next
+			case -2:
+				offset = 0
+			default:
+				offset, timestamp = p.offsetForTimestamp(rp.Timestamp)
+			}
+
+			if req.Version() == 0 {
+				if offset >= 0 {
+					pr.OldStyleOffsets = []int64{offset}
+				}
+			} else {
+				pr.Offset, pr.Timestamp, pr.LeaderEpoch = offset, timestamp, 0
+			}
+			tr.Partitions = append(tr.Partitions, pr)
+		}
+		res.Topics = append(res.Topics, tr)
+	}
+	return res
+}
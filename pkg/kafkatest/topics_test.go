@@ -0,0 +1,201 @@
+package kafkatest
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/client"
+	"github.com/ethanmoffat/kafka-protocol/pkg/compress"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+)
+
+// newBroker starts a broker with a topic "t" of one partition.
+func newBroker(t *testing.T) *Broker {
+	t.Helper()
+	b, err := NewBroker()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { b.Close() })
+	if err := b.CreateTopic("t", 1); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// dial returns a connection to b that sends requests at the version they were built with. The
+// broker answers the requests of a connection one at a time.
+func dial(t *testing.T, b *Broker) *client.Conn {
+	t.Helper()
+	c, err := client.Dial(b.Addr(), client.WithoutNegotiation())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func batch(t *testing.T, id compress.Id, values ...string) []byte {
+	t.Helper()
+	b := &protocol.RecordBatch{
+		Attributes:    int16(id),
+		BaseTimestamp: 1000,
+		MaxTimestamp:  1000 + int64(len(values)) - 1,
+		ProducerId:    -1,
+		ProducerEpoch: -1,
+		BaseSequence:  -1,
+	}
+	for i, v := range values {
+		b.Records = append(b.Records, protocol.Record{TimestampDelta: int64(i), OffsetDelta: int32(i), Value: []byte(v)})
+	}
+	b.LastOffsetDelta = int32(len(values) - 1)
+	var buf bytes.Buffer
+	if err := b.Encode(protocol.NewMessageWriter(&buf)); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// produce sends records to partition 0 of "t" and returns the partition of the response.
+func produce(t *testing.T, c *client.Conn, version int, records []byte) messages.ProduceResponsePartitionProduceResponse {
+	t.Helper()
+	req := messages.NewProduceRequest(version)
+	req.Acks, req.TimeoutMs = -1, 1000
+	req.TopicData = []messages.ProduceRequestTopicProduceData{{
+		Name:          "t",
+		PartitionData: []messages.ProduceRequestPartitionProduceData{{Records: records}},
+	}}
+	res, err := c.RoundTrip(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.(*messages.ProduceResponse).Responses[0].PartitionResponses[0]
+}
+
+// fetch reads partition 0 of "t" from offset and returns the partition of the response.
+func fetch(t *testing.T, c *client.Conn, version int, offset int64) messages.FetchResponsePartitionData {
+	t.Helper()
+	req := messages.NewFetchRequest(version)
+	req.ReplicaId, req.MaxBytes = -1, 1<<20
+	var fp messages.FetchRequestFetchPartition
+	fp.SetDefaults()
+	fp.FetchOffset, fp.PartitionMaxBytes = offset, 1<<20
+	req.Topics = []messages.FetchRequestFetchTopic{{Topic: "t", Partitions: []messages.FetchRequestFetchPartition{fp}}}
+	res, err := c.RoundTrip(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.(*messages.FetchResponse).Responses[0].Partitions[0]
+}
+
+func TestZstdVersions(t *testing.T) {
+	c := dial(t, newBroker(t))
+	records := batch(t, compress.Zstd, "a", "b")
+
+	if pr := produce(t, c, 6, records); pr.ErrorCode != int16(protocol.ErrUnsupportedCompressionType) {
+		t.Errorf("produce v6: error %d", pr.ErrorCode)
+	}
+	if pr := produce(t, c, 7, records); pr.ErrorCode != 0 || pr.BaseOffset != 0 {
+		t.Fatalf("produce v7: error %d at offset %d", pr.ErrorCode, pr.BaseOffset)
+	}
+
+	if pr := fetch(t, c, 9, 0); pr.ErrorCode != int16(protocol.ErrUnsupportedCompressionType) || len(pr.Records) != 0 {
+		t.Errorf("fetch v9: error %d with %d bytes", pr.ErrorCode, len(pr.Records))
+	}
+	pr := fetch(t, c, 10, 0)
+	if pr.ErrorCode != 0 {
+		t.Fatalf("fetch v10: error %d", pr.ErrorCode)
+	}
+	batches, err := protocol.DecodeFetchRecords(10, pr.Records)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 1 || len(batches[0].(*protocol.RecordBatch).Records) != 2 {
+		t.Errorf("fetched %+v", batches)
+	}
+}
+
+// listOffset returns the offset of partition 0 of "t" for a timestamp, or -1 and -2 for the end
+// and the start of the log.
+func listOffset(t *testing.T, c *client.Conn, version int, timestamp int64) messages.ListOffsetsResponseListOffsetsPartitionResponse {
+	t.Helper()
+	req := messages.NewListOffsetsRequest(version)
+	req.ReplicaId = -1
+	req.Topics = []messages.ListOffsetsRequestListOffsetsTopic{{
+		Name:       "t",
+		Partitions: []messages.ListOffsetsRequestListOffsetsPartition{{Timestamp: timestamp, MaxNumOffsets: 1}},
+	}}
+	res, err := c.RoundTrip(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.(*messages.ListOffsetsResponse).Topics[0].Partitions[0]
+}
+
+func TestProduceFetch(t *testing.T) {
+	c := dial(t, newBroker(t))
+	if pr := produce(t, c, 7, batch(t, compress.None, "a", "b")); pr.ErrorCode != 0 || pr.BaseOffset != 0 {
+		t.Fatalf("produce: error %d at offset %d", pr.ErrorCode, pr.BaseOffset)
+	}
+	if pr := produce(t, c, 7, batch(t, compress.Gzip, "c")); pr.ErrorCode != 0 || pr.BaseOffset != 2 {
+		t.Fatalf("produce: error %d at offset %d", pr.ErrorCode, pr.BaseOffset)
+	}
+
+	pr := fetch(t, c, 11, 1)
+	if pr.ErrorCode != 0 || pr.HighWatermark != 3 {
+		t.Fatalf("fetch: error %d, high watermark %d", pr.ErrorCode, pr.HighWatermark)
+	}
+	batches, err := protocol.DecodeRecordBatches(pr.Records)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the batch that holds the offset is returned whole
+	if len(batches) != 2 || batches[0].BaseOffset != 0 || batches[1].BaseOffset != 2 || string(batches[1].Records[0].Value) != "c" {
+		t.Errorf("fetched %+v", batches)
+	}
+
+	// older versions get the records as legacy messages, with timestamps from magic v1
+	for _, version := range []int{1, 3} {
+		pr := fetch(t, c, version, 0)
+		sets, err := protocol.DecodeRecords(pr.Records)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sets) != 3 {
+			t.Fatalf("fetch v%d: %d entries, want 3", version, len(sets))
+		}
+		for i, set := range sets {
+			m, ok := set.(*protocol.LegacyMessage)
+			if !ok {
+				t.Fatalf("fetch v%d: entry %d is a %T", version, i, set)
+			}
+			magic, timestamp := int8(1), int64(1000+i%2)
+			if version < 2 {
+				magic, timestamp = 0, 0
+			}
+			if m.Offset != int64(i) || m.Magic() != magic || m.Timestamp != timestamp || string(m.Value) != "abc"[i:i+1] {
+				t.Errorf("fetch v%d: entry %d is %+v", version, i, m)
+			}
+		}
+	}
+
+	tests := []struct {
+		timestamp int64
+		offset    int64
+	}{
+		{-1, 3},
+		{-2, 0},
+		{1001, 1},
+		{2000, -1},
+	}
+	for _, tt := range tests {
+		if pr := listOffset(t, c, 1, tt.timestamp); pr.ErrorCode != 0 || pr.Offset != tt.offset {
+			t.Errorf("ListOffsets(%d) = %d, error %d, want %d", tt.timestamp, pr.Offset, pr.ErrorCode, tt.offset)
+		}
+	}
+	if pr := listOffset(t, c, 0, -1); len(pr.OldStyleOffsets) != 1 || pr.OldStyleOffsets[0] != 3 {
+		t.Errorf("ListOffsets v0 = %v, want [3]", pr.OldStyleOffsets)
+	}
+}
@@ -90,12 +90,18 @@ const (
 	AlterClientQuotas            ApiKey = 49
 	DescribeUserScramCredentials ApiKey = 50
 	AlterUserScramCredentials    ApiKey = 51
+	Vote                         ApiKey = 52
+	BeginQuorumEpoch             ApiKey = 53
+	EndQuorumEpoch               ApiKey = 54
 	DescribeQuorum               ApiKey = 55
 	AlterPartition               ApiKey = 56
 	UpdateFeatures               ApiKey = 57
 	Envelope                     ApiKey = 58
+	FetchSnapshot                ApiKey = 59
 	DescribeCluster              ApiKey = 60
 	DescribeProducers            ApiKey = 61
+	BrokerRegistration           ApiKey = 62
+	BrokerHeartbeat              ApiKey = 63
 	UnregisterBroker             ApiKey = 64
 	DescribeTransactions         ApiKey = 65
 	ListTransactions             ApiKey = 66
@@ -156,12 +162,18 @@ var apiNames = map[ApiKey]string{
 	AlterClientQuotas:            "AlterClientQuotas",
 	DescribeUserScramCredentials: "DescribeUserScramCredentials",
 	AlterUserScramCredentials:    "AlterUserScramCredentials",
+	Vote:                         "Vote",
+	BeginQuorumEpoch:             "BeginQuorumEpoch",
+	EndQuorumEpoch:               "EndQuorumEpoch",
 	DescribeQuorum:               "DescribeQuorum",
 	AlterPartition:               "AlterPartition",
 	UpdateFeatures:               "UpdateFeatures",
 	Envelope:                     "Envelope",
+	FetchSnapshot:                "FetchSnapshot",
 	DescribeCluster:              "DescribeCluster",
 	DescribeProducers:            "DescribeProducers",
+	BrokerRegistration:           "BrokerRegistration",
+	BrokerHeartbeat:              "BrokerHeartbeat",
 	UnregisterBroker:             "UnregisterBroker",
 	DescribeTransactions:         "DescribeTransactions",
 	ListTransactions:             "ListTransactions",
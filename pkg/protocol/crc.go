@@ -0,0 +1,55 @@
+package protocol
+
+import (
+	"fmt"
+	"hash/crc32"
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// Crc32c returns the CRC-32C (Castagnoli) checksum of b, as used by record batches.
+func Crc32c(b []byte) uint32 {
+	return crc32.Checksum(b, castagnoli)
+}
+
+// Crc32 returns the IEEE CRC-32 checksum of b, as used by legacy messages.
+func Crc32(b []byte) uint32 {
+	return crc32.ChecksumIEEE(b)
+}
+
+// ChecksumError is returned when the checksum of a record batch or legacy message does not match
+// its contents. It wraps ErrCorruptRecords.
+type ChecksumError struct {
+	// Offset is the base offset of the batch or the offset of the message.
+	Offset   int64
+	Expected uint32
+	Actual   uint32
+}
+
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("%v: crc %08x at offset %d does not match %08x", ErrCorruptRecords, e.Actual, e.Offset, e.Expected)
+}
+
+func (e *ChecksumError) Unwrap() error {
+	return ErrCorruptRecords
+}
+
+// SkipChecksums disables the verification of the checksums of record batches and legacy messages.
+// Checksums are verified by default.
+func SkipChecksums() DecodeOption {
+	return func(o *decodeOptions) {
+		o.skipChecksums = true
+	}
+}
+
+// verifyChecksum returns a ChecksumError if the checksum of an entry does not match, unless
+// checksums are skipped.
+func (o decodeOptions) verifyChecksum(offset int64, expected uint32, checksum func([]byte) uint32, data []byte) error {
+	if o.skipChecksums {
+		return nil
+	}
+	if actual := checksum(data); actual != expected {
+		return &ChecksumError{Offset: offset, Expected: expected, Actual: actual}
+	}
+	return nil
+}
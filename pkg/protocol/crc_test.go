@@ -0,0 +1,47 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+func TestChecksumError(t *testing.T) {
+	batch := encodeBatch(t, &RecordBatch{BaseOffset: 40, Records: []Record{{Value: []byte("v")}}})
+	message := encodeLegacyMessage(t, &LegacyMessage{Offset: 7, MagicByte: 1, Value: []byte("v")})
+
+	tests := []struct {
+		name string
+		data []byte
+		// crc is the position of the checksum, which covers the data after it
+		crc      int
+		checksum func([]byte) uint32
+		offset   int64
+	}{
+		{"record batch", batch, 17, Crc32c, 40},
+		{"legacy message", message, 12, Crc32, 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binary.BigEndian.PutUint32(tt.data[tt.crc:], 0xdeadbeef)
+
+			_, err := DecodeRecords(tt.data)
+			var checksumErr *ChecksumError
+			if !errors.As(err, &checksumErr) {
+				t.Fatalf("DecodeRecords() error = %v, want a ChecksumError", err)
+			}
+			want := ChecksumError{Offset: tt.offset, Expected: 0xdeadbeef, Actual: tt.checksum(tt.data[tt.crc+4:])}
+			if *checksumErr != want {
+				t.Errorf("error = %+v, want %+v", *checksumErr, want)
+			}
+			if !errors.Is(err, ErrCorruptRecords) {
+				t.Errorf("error %v is not ErrCorruptRecords", err)
+			}
+
+			sets, err := DecodeRecords(tt.data, SkipChecksums())
+			if err != nil || len(sets) != 1 {
+				t.Errorf("DecodeRecords() skipping checksums = %v, %v", sets, err)
+			}
+		})
+	}
+}
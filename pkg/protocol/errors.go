@@ -0,0 +1,313 @@
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrorCode is an error code of a Kafka response. Codes other than NoError implement error, and
+// can be matched with errors.Is after being wrapped.
+type ErrorCode int16
+
+const (
+	ErrUnknownServerError                 ErrorCode = -1
+	NoError                               ErrorCode = 0
+	ErrOffsetOutOfRange                   ErrorCode = 1
+	ErrCorruptMessage                     ErrorCode = 2
+	ErrUnknownTopicOrPartition            ErrorCode = 3
+	ErrInvalidFetchSize                   ErrorCode = 4
+	ErrLeaderNotAvailable                 ErrorCode = 5
+	ErrNotLeaderOrFollower                ErrorCode = 6
+	ErrRequestTimedOut                    ErrorCode = 7
+	ErrBrokerNotAvailable                 ErrorCode = 8
+	ErrReplicaNotAvailable                ErrorCode = 9
+	ErrMessageTooLarge                    ErrorCode = 10
+	ErrStaleControllerEpoch               ErrorCode = 11
+	ErrOffsetMetadataTooLarge             ErrorCode = 12
+	ErrNetworkException                   ErrorCode = 13
+	ErrCoordinatorLoadInProgress          ErrorCode = 14
+	ErrCoordinatorNotAvailable            ErrorCode = 15
+	ErrNotCoordinator                     ErrorCode = 16
+	ErrInvalidTopicException              ErrorCode = 17
+	ErrRecordListTooLarge                 ErrorCode = 18
+	ErrNotEnoughReplicas                  ErrorCode = 19
+	ErrNotEnoughReplicasAfterAppend       ErrorCode = 20
+	ErrInvalidRequiredAcks                ErrorCode = 21
+	ErrIllegalGeneration                  ErrorCode = 22
+	ErrInconsistentGroupProtocol          ErrorCode = 23
+	ErrInvalidGroupId                     ErrorCode = 24
+	ErrUnknownMemberId                    ErrorCode = 25
+	ErrInvalidSessionTimeout              ErrorCode = 26
+	ErrRebalanceInProgress                ErrorCode = 27
+	ErrInvalidCommitOffsetSize            ErrorCode = 28
+	ErrTopicAuthorizationFailed           ErrorCode = 29
+	ErrGroupAuthorizationFailed           ErrorCode = 30
+	ErrClusterAuthorizationFailed         ErrorCode = 31
+	ErrInvalidTimestamp                   ErrorCode = 32
+	ErrUnsupportedSaslMechanism           ErrorCode = 33
+	ErrIllegalSaslState                   ErrorCode = 34
+	ErrUnsupportedVersion                 ErrorCode = 35
+	ErrTopicAlreadyExists                 ErrorCode = 36
+	ErrInvalidPartitions                  ErrorCode = 37
+	ErrInvalidReplicationFactor           ErrorCode = 38
+	ErrInvalidReplicaAssignment           ErrorCode = 39
+	ErrInvalidConfig                      ErrorCode = 40
+	ErrNotController                      ErrorCode = 41
+	ErrInvalidRequest                     ErrorCode = 42
+	ErrUnsupportedForMessageFormat        ErrorCode = 43
+	ErrPolicyViolation                    ErrorCode = 44
+	ErrOutOfOrderSequenceNumber           ErrorCode = 45
+	ErrDuplicateSequenceNumber            ErrorCode = 46
+	ErrInvalidProducerEpoch               ErrorCode = 47
+	ErrInvalidTxnState                    ErrorCode = 48
+	ErrInvalidProducerIdMapping           ErrorCode = 49
+	ErrInvalidTransactionTimeout          ErrorCode = 50
+	ErrConcurrentTransactions             ErrorCode = 51
+	ErrTransactionCoordinatorFenced       ErrorCode = 52
+	ErrTransactionalIdAuthorizationFailed ErrorCode = 53
+	ErrSecurityDisabled                   ErrorCode = 54
+	ErrOperationNotAttempted              ErrorCode = 55
+	ErrKafkaStorageError                  ErrorCode = 56
+	ErrLogDirNotFound                     ErrorCode = 57
+	ErrSaslAuthenticationFailed           ErrorCode = 58
+	ErrUnknownProducerId                  ErrorCode = 59
+	ErrReassignmentInProgress             ErrorCode = 60
+	ErrDelegationTokenAuthDisabled        ErrorCode = 61
+	ErrDelegationTokenNotFound            ErrorCode = 62
+	ErrDelegationTokenOwnerMismatch       ErrorCode = 63
+	ErrDelegationTokenRequestNotAllowed   ErrorCode = 64
+	ErrDelegationTokenAuthorizationFailed ErrorCode = 65
+	ErrDelegationTokenExpired             ErrorCode = 66
+	ErrInvalidPrincipalType               ErrorCode = 67
+	ErrNonEmptyGroup                      ErrorCode = 68
+	ErrGroupIdNotFound                    ErrorCode = 69
+	ErrFetchSessionIdNotFound             ErrorCode = 70
+	ErrInvalidFetchSessionEpoch           ErrorCode = 71
+	ErrListenerNotFound                   ErrorCode = 72
+	ErrTopicDeletionDisabled              ErrorCode = 73
+	ErrFencedLeaderEpoch                  ErrorCode = 74
+	ErrUnknownLeaderEpoch                 ErrorCode = 75
+	ErrUnsupportedCompressionType         ErrorCode = 76
+	ErrStaleBrokerEpoch                   ErrorCode = 77
+	ErrOffsetNotAvailable                 ErrorCode = 78
+	ErrMemberIdRequired                   ErrorCode = 79
+	ErrPreferredLeaderNotAvailable        ErrorCode = 80
+	ErrGroupMaxSizeReached                ErrorCode = 81
+	ErrFencedInstanceId                   ErrorCode = 82
+	ErrEligibleLeadersNotAvailable        ErrorCode = 83
+	ErrElectionNotNeeded                  ErrorCode = 84
+	ErrNoReassignmentInProgress           ErrorCode = 85
+	ErrGroupSubscribedToTopic             ErrorCode = 86
+	ErrInvalidRecord                      ErrorCode = 87
+	ErrUnstableOffsetCommit               ErrorCode = 88
+	ErrThrottlingQuotaExceeded            ErrorCode = 89
+	ErrProducerFenced                     ErrorCode = 90
+	ErrResourceNotFound                   ErrorCode = 91
+	ErrDuplicateResource                  ErrorCode = 92
+	ErrUnacceptableCredential             ErrorCode = 93
+	ErrInconsistentVoterSet               ErrorCode = 94
+	ErrInvalidUpdateVersion               ErrorCode = 95
+	ErrFeatureUpdateFailed                ErrorCode = 96
+	ErrPrincipalDeserializationFailure    ErrorCode = 97
+	ErrSnapshotNotFound                   ErrorCode = 98
+	ErrPositionOutOfRange                 ErrorCode = 99
+	ErrUnknownTopicId                     ErrorCode = 100
+	ErrDuplicateBrokerRegistration        ErrorCode = 101
+	ErrBrokerIdNotRegistered              ErrorCode = 102
+	ErrInconsistentTopicId                ErrorCode = 103
+	ErrInconsistentClusterId              ErrorCode = 104
+	ErrTransactionalIdNotFound            ErrorCode = 105
+	ErrFetchSessionTopicIdError           ErrorCode = 106
+	ErrIneligibleReplica                  ErrorCode = 107
+	ErrNewLeaderElected                   ErrorCode = 108
+	ErrOffsetMovedToTieredStorage         ErrorCode = 109
+	ErrFencedMemberEpoch                  ErrorCode = 110
+	ErrUnreleasedInstanceId               ErrorCode = 111
+	ErrUnsupportedAssignor                ErrorCode = 112
+	ErrStaleMemberEpoch                   ErrorCode = 113
+	ErrMismatchedEndpointType             ErrorCode = 114
+	ErrUnsupportedEndpointType            ErrorCode = 115
+	ErrUnknownControllerId                ErrorCode = 116
+	ErrUnknownSubscriptionId              ErrorCode = 117
+	ErrTelemetryTooLarge                  ErrorCode = 118
+	ErrInvalidRegistration                ErrorCode = 119
+
+	// ErrNotLeader is the name of ErrNotLeaderOrFollower before Kafka 2.7.
+	ErrNotLeader = ErrNotLeaderOrFollower
+)
+
+// ErrRetriable matches every retriable ErrorCode with errors.Is.
+var ErrRetriable = errors.New("retriable error")
+
+type errorInfo struct {
+	name        string
+	description string
+	retriable   bool
+}
+
+var errorInfos = map[ErrorCode]errorInfo{
+	ErrUnknownServerError:                 {"UNKNOWN_SERVER_ERROR", "The server experienced an unexpected error when processing the request.", false},
+	NoError:                               {"NONE", "", false},
+	ErrOffsetOutOfRange:                   {"OFFSET_OUT_OF_RANGE", "The requested offset is not within the range of offsets maintained by the server.", false},
+	ErrCorruptMessage:                     {"CORRUPT_MESSAGE", "This message has failed its CRC checksum, exceeds the valid size, has a null key for a compacted topic, or is otherwise corrupt.", true},
+	ErrUnknownTopicOrPartition:            {"UNKNOWN_TOPIC_OR_PARTITION", "This server does not host this topic-partition.", true},
+	ErrInvalidFetchSize:                   {"INVALID_FETCH_SIZE", "The requested fetch size is invalid.", false},
+	ErrLeaderNotAvailable:                 {"LEADER_NOT_AVAILABLE", "There is no leader for this topic-partition as we are in the middle of a leadership election.", true},
+	ErrNotLeaderOrFollower:                {"NOT_LEADER_OR_FOLLOWER", "For requests intended only for the leader, this error indicates that the broker is not the current leader. For requests intended for any replica, this error indicates that the broker is not a replica of the topic partition.", true},
+	ErrRequestTimedOut:                    {"REQUEST_TIMED_OUT", "The request timed out.", true},
+	ErrBrokerNotAvailable:                 {"BROKER_NOT_AVAILABLE", "The broker is not available.", false},
+	ErrReplicaNotAvailable:                {"REPLICA_NOT_AVAILABLE", "The replica is not available for the requested topic-partition.", true},
+	ErrMessageTooLarge:                    {"MESSAGE_TOO_LARGE", "The request included a message larger than the max message size the server will accept.", false},
+	ErrStaleControllerEpoch:               {"STALE_CONTROLLER_EPOCH", "The controller moved to another broker.", false},
+	ErrOffsetMetadataTooLarge:             {"OFFSET_METADATA_TOO_LARGE", "The metadata field of the offset request was too large.", false},
+	ErrNetworkException:                   {"NETWORK_EXCEPTION", "The server disconnected before a response was received.", true},
+	ErrCoordinatorLoadInProgress:          {"COORDINATOR_LOAD_IN_PROGRESS", "The coordinator is loading and hence can't process requests.", true},
+	ErrCoordinatorNotAvailable:            {"COORDINATOR_NOT_AVAILABLE", "The coordinator is not available.", true},
+	ErrNotCoordinator:                     {"NOT_COORDINATOR", "This is not the correct coordinator.", true},
+	ErrInvalidTopicException:              {"INVALID_TOPIC_EXCEPTION", "The request attempted to perform an operation on an invalid topic.", false},
+	ErrRecordListTooLarge:                 {"RECORD_LIST_TOO_LARGE", "The request included message batch larger than the configured segment size on the server.", false},
+	ErrNotEnoughReplicas:                  {"NOT_ENOUGH_REPLICAS", "Messages are rejected since there are fewer in-sync replicas than required.", true},
+	ErrNotEnoughReplicasAfterAppend:       {"NOT_ENOUGH_REPLICAS_AFTER_APPEND", "Messages are written to the log, but to fewer in-sync replicas than required.", true},
+	ErrInvalidRequiredAcks:                {"INVALID_REQUIRED_ACKS", "Produce request specified an invalid value for required acks.", false},
+	ErrIllegalGeneration:                  {"ILLEGAL_GENERATION", "Specified group generation id is not valid.", false},
+	ErrInconsistentGroupProtocol:          {"INCONSISTENT_GROUP_PROTOCOL", "The group member's supported protocols are incompatible with those of existing members or first group member tried to join with empty protocol type or empty protocol list.", false},
+	ErrInvalidGroupId:                     {"INVALID_GROUP_ID", "The configured groupId is invalid.", false},
+	ErrUnknownMemberId:                    {"UNKNOWN_MEMBER_ID", "The coordinator is not aware of this member.", false},
+	ErrInvalidSessionTimeout:              {"INVALID_SESSION_TIMEOUT", "The session timeout is not within the range allowed by the broker (as configured by group.min.session.timeout.ms and group.max.session.timeout.ms).", false},
+	ErrRebalanceInProgress:                {"REBALANCE_IN_PROGRESS", "The group is rebalancing, so a rejoin is needed.", false},
+	ErrInvalidCommitOffsetSize:            {"INVALID_COMMIT_OFFSET_SIZE", "The committing offset data size is not valid.", false},
+	ErrTopicAuthorizationFailed:           {"TOPIC_AUTHORIZATION_FAILED", "Topic authorization failed.", false},
+	ErrGroupAuthorizationFailed:           {"GROUP_AUTHORIZATION_FAILED", "Group authorization failed.", false},
+	ErrClusterAuthorizationFailed:         {"CLUSTER_AUTHORIZATION_FAILED", "Cluster authorization failed.", false},
+	ErrInvalidTimestamp:                   {"INVALID_TIMESTAMP", "The timestamp of the message is out of acceptable range.", false},
+	ErrUnsupportedSaslMechanism:           {"UNSUPPORTED_SASL_MECHANISM", "The broker does not support the requested SASL mechanism.", false},
+	ErrIllegalSaslState:                   {"ILLEGAL_SASL_STATE", "Request is not valid given the current SASL state.", false},
+	ErrUnsupportedVersion:                 {"UNSUPPORTED_VERSION", "The version of API is not supported.", false},
+	ErrTopicAlreadyExists:                 {"TOPIC_ALREADY_EXISTS", "Topic with this name already exists.", false},
+	ErrInvalidPartitions:                  {"INVALID_PARTITIONS", "Number of partitions is below 1.", false},
+	ErrInvalidReplicationFactor:           {"INVALID_REPLICATION_FACTOR", "Replication factor is below 1 or larger than the number of available brokers.", false},
+	ErrInvalidReplicaAssignment:           {"INVALID_REPLICA_ASSIGNMENT", "Replica assignment is invalid.", false},
+	ErrInvalidConfig:                      {"INVALID_CONFIG", "Configuration is invalid.", false},
+	ErrNotController:                      {"NOT_CONTROLLER", "This is not the correct controller for this cluster.", true},
+	ErrInvalidRequest:                     {"INVALID_REQUEST", "This most likely occurs because of a request being malformed by the client library or the message was sent to an incompatible broker. See the broker logs for more details.", false},
+	ErrUnsupportedForMessageFormat:        {"UNSUPPORTED_FOR_MESSAGE_FORMAT", "The message format version on the broker does not support the request.", false},
+	ErrPolicyViolation:                    {"POLICY_VIOLATION", "Request parameters do not satisfy the configured policy.", false},
+	ErrOutOfOrderSequenceNumber:           {"OUT_OF_ORDER_SEQUENCE_NUMBER", "The broker received an out of order sequence number.", false},
+	ErrDuplicateSequenceNumber:            {"DUPLICATE_SEQUENCE_NUMBER", "The broker received a duplicate sequence number.", false},
+	ErrInvalidProducerEpoch:               {"INVALID_PRODUCER_EPOCH", "Producer attempted to produce with an old epoch.", false},
+	ErrInvalidTxnState:                    {"INVALID_TXN_STATE", "The producer attempted a transactional operation in an invalid state.", false},
+	ErrInvalidProducerIdMapping:           {"INVALID_PRODUCER_ID_MAPPING", "The producer attempted to use a producer id which is not currently assigned to its transactional id.", false},
+	ErrInvalidTransactionTimeout:          {"INVALID_TRANSACTION_TIMEOUT", "The transaction timeout is larger than the maximum value allowed by the broker (as configured by transaction.max.timeout.ms).", false},
+	ErrConcurrentTransactions:             {"CONCURRENT_TRANSACTIONS", "The producer attempted to update a transaction while another concurrent operation on the same transaction was ongoing.", true},
+	ErrTransactionCoordinatorFenced:       {"TRANSACTION_COORDINATOR_FENCED", "Indicates that the transaction coordinator sending a WriteTxnMarker is no longer the current coordinator for a given producer.", false},
+	ErrTransactionalIdAuthorizationFailed: {"TRANSACTIONAL_ID_AUTHORIZATION_FAILED", "Transactional Id authorization failed.", false},
+	ErrSecurityDisabled:                   {"SECURITY_DISABLED", "Security features are disabled.", false},
+	ErrOperationNotAttempted:              {"OPERATION_NOT_ATTEMPTED", "The broker did not attempt to execute this operation. This may happen for batched RPCs where some operations in the batch failed, causing the broker to respond without trying the rest.", false},
+	ErrKafkaStorageError:                  {"KAFKA_STORAGE_ERROR", "Disk error when trying to access log file on the disk.", true},
+	ErrLogDirNotFound:                     {"LOG_DIR_NOT_FOUND", "The user-specified log directory is not found in the broker config.", false},
+	ErrSaslAuthenticationFailed:           {"SASL_AUTHENTICATION_FAILED", "SASL Authentication failed.", false},
+	ErrUnknownProducerId:                  {"UNKNOWN_PRODUCER_ID", "This exception is raised by the broker if it could not locate the producer metadata associated with the producerId in question.", false},
+	ErrReassignmentInProgress:             {"REASSIGNMENT_IN_PROGRESS", "A partition reassignment is in progress.", false},
+	ErrDelegationTokenAuthDisabled:        {"DELEGATION_TOKEN_AUTH_DISABLED", "Delegation Token feature is not enabled.", false},
+	ErrDelegationTokenNotFound:            {"DELEGATION_TOKEN_NOT_FOUND", "Delegation Token is not found on server.", false},
+	ErrDelegationTokenOwnerMismatch:       {"DELEGATION_TOKEN_OWNER_MISMATCH", "Specified Principal is not valid Owner/Renewer.", false},
+	ErrDelegationTokenRequestNotAllowed:   {"DELEGATION_TOKEN_REQUEST_NOT_ALLOWED", "Delegation Token requests are not allowed on PLAINTEXT/1-way SSL channels and on delegation token authenticated channels.", false},
+	ErrDelegationTokenAuthorizationFailed: {"DELEGATION_TOKEN_AUTHORIZATION_FAILED", "Delegation Token authorization failed.", false},
+	ErrDelegationTokenExpired:             {"DELEGATION_TOKEN_EXPIRED", "Delegation Token is expired.", false},
+	ErrInvalidPrincipalType:               {"INVALID_PRINCIPAL_TYPE", "Supplied principalType is not supported.", false},
+	ErrNonEmptyGroup:                      {"NON_EMPTY_GROUP", "The group is not empty.", false},
+	ErrGroupIdNotFound:                    {"GROUP_ID_NOT_FOUND", "The group id does not exist.", false},
+	ErrFetchSessionIdNotFound:             {"FETCH_SESSION_ID_NOT_FOUND", "The fetch session ID was not found.", true},
+	ErrInvalidFetchSessionEpoch:           {"INVALID_FETCH_SESSION_EPOCH", "The fetch session epoch is invalid.", true},
+	ErrListenerNotFound:                   {"LISTENER_NOT_FOUND", "There is no listener on the leader broker that matches the listener on which metadata request was processed.", true},
+	ErrTopicDeletionDisabled:              {"TOPIC_DELETION_DISABLED", "Topic deletion is disabled.", false},
+	ErrFencedLeaderEpoch:                  {"FENCED_LEADER_EPOCH", "The leader epoch in the request is older than the epoch on the broker.", true},
+	ErrUnknownLeaderEpoch:                 {"UNKNOWN_LEADER_EPOCH", "The leader epoch in the request is newer than the epoch on the broker.", true},
+	ErrUnsupportedCompressionType:         {"UNSUPPORTED_COMPRESSION_TYPE", "The requesting client does not support the compression type of given partition.", false},
+	ErrStaleBrokerEpoch:                   {"STALE_BROKER_EPOCH", "Broker epoch has changed.", false},
+	ErrOffsetNotAvailable:                 {"OFFSET_NOT_AVAILABLE", "The leader high watermark has not caught up from a recent leader election so the offsets cannot be guaranteed to be monotonically increasing.", true},
+	ErrMemberIdRequired:                   {"MEMBER_ID_REQUIRED", "The group member needs to have a valid member id before actually entering a consumer group.", false},
+	ErrPreferredLeaderNotAvailable:        {"PREFERRED_LEADER_NOT_AVAILABLE", "The preferred leader was not available.", true},
+	ErrGroupMaxSizeReached:                {"GROUP_MAX_SIZE_REACHED", "The consumer group has reached its max size.", false},
+	ErrFencedInstanceId:                   {"FENCED_INSTANCE_ID", "The broker rejected this static consumer since another consumer with the same group.instance.id has registered with a different member.id.", false},
+	ErrEligibleLeadersNotAvailable:        {"ELIGIBLE_LEADERS_NOT_AVAILABLE", "Eligible topic partition leaders are not available.", true},
+	ErrElectionNotNeeded:                  {"ELECTION_NOT_NEEDED", "Leader election not needed for topic partition.", true},
+	ErrNoReassignmentInProgress:           {"NO_REASSIGNMENT_IN_PROGRESS", "No partition reassignment is in progress.", false},
+	ErrGroupSubscribedToTopic:             {"GROUP_SUBSCRIBED_TO_TOPIC", "Deleting offsets of a topic is forbidden while the consumer group is actively subscribed to it.", false},
+	ErrInvalidRecord:                      {"INVALID_RECORD", "This record has failed the validation on broker and hence will be rejected.", false},
+	ErrUnstableOffsetCommit:               {"UNSTABLE_OFFSET_COMMIT", "There are unstable offsets that need to be cleared.", true},
+	ErrThrottlingQuotaExceeded:            {"THROTTLING_QUOTA_EXCEEDED", "The throttling quota has been exceeded.", true},
+	ErrProducerFenced:                     {"PRODUCER_FENCED", "There is a newer producer with the same transactionalId which fences the current one.", false},
+	ErrResourceNotFound:                   {"RESOURCE_NOT_FOUND", "A request illegally referred to a resource that does not exist.", false},
+	ErrDuplicateResource:                  {"DUPLICATE_RESOURCE", "A request illegally referred to the same resource twice.", false},
+	ErrUnacceptableCredential:             {"UNACCEPTABLE_CREDENTIAL", "Requested credential would not meet criteria for acceptability.", false},
+	ErrInconsistentVoterSet:               {"INCONSISTENT_VOTER_SET", "Indicates that the either the sender or recipient of a voter-only request is not one of the expected voters.", false},
+	ErrInvalidUpdateVersion:               {"INVALID_UPDATE_VERSION", "The given update version was invalid.", false},
+	ErrFeatureUpdateFailed:                {"FEATURE_UPDATE_FAILED", "Unable to update finalized features due to an unexpected server error.", false},
+	ErrPrincipalDeserializationFailure:    {"PRINCIPAL_DESERIALIZATION_FAILURE", "Request principal deserialization failed during forwarding. This indicates an internal error on the broker cluster security setup.", false},
+	ErrSnapshotNotFound:                   {"SNAPSHOT_NOT_FOUND", "Requested snapshot was not found.", false},
+	ErrPositionOutOfRange:                 {"POSITION_OUT_OF_RANGE", "Requested position is not greater than or equal to zero, and less than the size of the snapshot.", false},
+	ErrUnknownTopicId:                     {"UNKNOWN_TOPIC_ID", "This server does not host this topic ID.", true},
+	ErrDuplicateBrokerRegistration:        {"DUPLICATE_BROKER_REGISTRATION", "This broker ID is already in use.", false},
+	ErrBrokerIdNotRegistered:              {"BROKER_ID_NOT_REGISTERED", "The given broker ID was not registered.", false},
+	ErrInconsistentTopicId:                {"INCONSISTENT_TOPIC_ID", "The log's topic ID did not match the topic ID in the request.", true},
+	ErrInconsistentClusterId:              {"INCONSISTENT_CLUSTER_ID", "The clusterId in the request does not match that found on the server.", false},
+	ErrTransactionalIdNotFound:            {"TRANSACTIONAL_ID_NOT_FOUND", "The transactionalId could not be found.", false},
+	ErrFetchSessionTopicIdError:           {"FETCH_SESSION_TOPIC_ID_ERROR", "The fetch session encountered inconsistent topic ID usage.", true},
+	ErrIneligibleReplica:                  {"INELIGIBLE_REPLICA", "The new ISR contains at least one ineligible replica.", false},
+	ErrNewLeaderElected:                   {"NEW_LEADER_ELECTED", "The AlterPartition request successfully updated the partition state but the leader has changed.", false},
+	ErrOffsetMovedToTieredStorage:         {"OFFSET_MOVED_TO_TIERED_STORAGE", "The requested offset is moved to tiered storage.", false},
+	ErrFencedMemberEpoch:                  {"FENCED_MEMBER_EPOCH", "The member epoch is fenced by the group coordinator. The member must abandon all its partitions and rejoin.", false},
+	ErrUnreleasedInstanceId:               {"UNRELEASED_INSTANCE_ID", "The instance ID is still used by another member in the consumer group. That member must leave first.", false},
+	ErrUnsupportedAssignor:                {"UNSUPPORTED_ASSIGNOR", "The assignor or its version range is not supported by the consumer group.", false},
+	ErrStaleMemberEpoch:                   {"STALE_MEMBER_EPOCH", "The member epoch is stale. The member must retry after receiving its updated member epoch via the ConsumerGroupHeartbeat API.", false},
+	ErrMismatchedEndpointType:             {"MISMATCHED_ENDPOINT_TYPE", "The request was sent to an endpoint of the wrong type.", false},
+	ErrUnsupportedEndpointType:            {"UNSUPPORTED_ENDPOINT_TYPE", "This endpoint type is not supported yet.", false},
+	ErrUnknownControllerId:                {"UNKNOWN_CONTROLLER_ID", "This controller ID is not known.", false},
+	ErrUnknownSubscriptionId:              {"UNKNOWN_SUBSCRIPTION_ID", "Client sent a push telemetry request with an invalid or outdated subscription ID.", false},
+	ErrTelemetryTooLarge:                  {"TELEMETRY_TOO_LARGE", "Client sent a push telemetry request larger than the maximum size the broker will accept.", false},
+	ErrInvalidRegistration:                {"INVALID_REGISTRATION", "The controller has considered the broker registration to be invalid.", false},
+}
+
+// Err returns the code as an error, or nil for NoError.
+func (c ErrorCode) Err() error {
+	if c == NoError {
+		return nil
+	}
+	return c
+}
+
+func (c ErrorCode) Error() string {
+	info, ok := errorInfos[c]
+	if !ok {
+		return fmt.Sprintf("unknown error code %d", int16(c))
+	}
+	if info.description == "" {
+		return info.name
+	}
+	return info.name + ": " + strings.TrimSuffix(info.description, ".")
+}
+
+// String returns the name of the code, such as NOT_LEADER_OR_FOLLOWER.
+func (c ErrorCode) String() string {
+	if info, ok := errorInfos[c]; ok {
+		return info.name
+	}
+	return fmt.Sprintf("ERROR_CODE_%d", int16(c))
+}
+
+func (c ErrorCode) Description() string {
+	return errorInfos[c].description
+}
+
+// Retriable reports whether a request that failed with the code may succeed if it is retried,
+// possibly after refreshing metadata.
+func (c ErrorCode) Retriable() bool {
+	return errorInfos[c].retriable
+}
+
+// Is makes retriable codes match ErrRetriable.
+func (c ErrorCode) Is(target error) bool {
+	return target == ErrRetriable && c.Retriable()
+}
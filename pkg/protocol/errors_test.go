@@ -0,0 +1,57 @@
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		code      ErrorCode
+		name      string
+		error     string
+		retriable bool
+	}{
+		{NoError, "NONE", "NONE", false},
+		{ErrUnknownServerError, "UNKNOWN_SERVER_ERROR", "UNKNOWN_SERVER_ERROR: The server experienced an unexpected error when processing the request", false},
+		{ErrNotLeaderOrFollower, "NOT_LEADER_OR_FOLLOWER", "", true},
+		{ErrUnsupportedVersion, "UNSUPPORTED_VERSION", "UNSUPPORTED_VERSION: The version of API is not supported", false},
+		{ErrorCode(1000), "ERROR_CODE_1000", "unknown error code 1000", false},
+	}
+	for _, tt := range tests {
+		if got := tt.code.String(); got != tt.name {
+			t.Errorf("ErrorCode(%d).String() = %q, want %q", tt.code, got, tt.name)
+		}
+		if got := tt.code.Error(); tt.error != "" && got != tt.error {
+			t.Errorf("ErrorCode(%d).Error() = %q, want %q", tt.code, got, tt.error)
+		}
+		if got := tt.code.Retriable(); got != tt.retriable {
+			t.Errorf("ErrorCode(%d).Retriable() = %v", tt.code, got)
+		}
+		if got := errors.Is(tt.code, ErrRetriable); got != tt.retriable {
+			t.Errorf("errors.Is(%v, ErrRetriable) = %v", tt.code, got)
+		}
+	}
+
+	if NoError.Err() != nil || ErrRequestTimedOut.Err() != ErrRequestTimedOut {
+		t.Error("Err() of NoError and ErrRequestTimedOut")
+	}
+}
+
+func TestErrorCodeIs(t *testing.T) {
+	err := fmt.Errorf("produce: %w", ErrNotLeader)
+	if !errors.Is(err, ErrNotLeaderOrFollower) || !errors.Is(err, ErrRetriable) {
+		t.Errorf("%v does not match NOT_LEADER_OR_FOLLOWER", err)
+	}
+	if errors.Is(err, ErrLeaderNotAvailable) {
+		t.Errorf("%v matches LEADER_NOT_AVAILABLE", err)
+	}
+	var code ErrorCode
+	if !errors.As(err, &code) || code != ErrNotLeaderOrFollower || code.String() != "NOT_LEADER_OR_FOLLOWER" {
+		t.Errorf("errors.As(%v) = %v", err, code)
+	}
+	if errors.Is(fmt.Errorf("%w", ErrInvalidRequest), ErrRetriable) {
+		t.Error("INVALID_REQUEST matches ErrRetriable")
+	}
+}
@@ -0,0 +1,91 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// DefaultMaxFrameSize is the largest frame a FrameReader accepts if no maximum is given. It
+// matches the default socket.request.max.bytes of the broker.
+const DefaultMaxFrameSize = 100 * 1024 * 1024
+
+var ErrInvalidFrameSize = errors.New("invalid frame size")
+
+// FrameTooLargeError is returned when the size prefix of a frame exceeds the maximum frame size.
+type FrameTooLargeError struct {
+	Size    int
+	MaxSize int
+}
+
+func (e *FrameTooLargeError) Error() string {
+	return fmt.Sprintf("frame of %d bytes exceeds the maximum of %d bytes", e.Size, e.MaxSize)
+}
+
+// FrameReader reads int32 size-prefixed frames.
+type FrameReader struct {
+	reader  io.Reader
+	maxSize int
+	buf     [4]byte
+}
+
+// NewFrameReader returns a FrameReader that refuses frames larger than maxSize bytes. If maxSize
+// is not positive, DefaultMaxFrameSize is used.
+func NewFrameReader(reader io.Reader, maxSize int) *FrameReader {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxFrameSize
+	}
+	return &FrameReader{reader: reader, maxSize: maxSize}
+}
+
+// ReadFrame reads the next frame and returns its contents without the size prefix. It returns
+// io.EOF only if the reader ends before the first byte of the frame.
+func (r *FrameReader) ReadFrame() ([]byte, error) {
+	if _, err := io.ReadFull(r.reader, r.buf[:]); err != nil {
+		return nil, err
+	}
+
+	size := int(int32(binary.BigEndian.Uint32(r.buf[:])))
+	if size < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidFrameSize, size)
+	}
+	if size > r.maxSize {
+		return nil, &FrameTooLargeError{Size: size, MaxSize: r.maxSize}
+	}
+
+	frame := make([]byte, size)
+	if _, err := io.ReadFull(r.reader, frame); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return frame, nil
+}
+
+// FrameWriter writes int32 size-prefixed frames.
+type FrameWriter struct {
+	writer io.Writer
+	buf    []byte
+}
+
+func NewFrameWriter(writer io.Writer) *FrameWriter {
+	return &FrameWriter{writer: writer}
+}
+
+// WriteFrame writes a frame containing header followed by body. The frame is written with a single
+// call to the underlying writer so that frames from concurrent writers are not interleaved if the
+// writer is safe for concurrent use.
+func (w *FrameWriter) WriteFrame(header, body []byte) error {
+	size := len(header) + len(body)
+	if size > 1<<31-1 {
+		return fmt.Errorf("%w: %d", ErrInvalidFrameSize, size)
+	}
+
+	w.buf = binary.BigEndian.AppendUint32(w.buf[:0], uint32(size))
+	w.buf = append(w.buf, header...)
+	w.buf = append(w.buf, body...)
+	_, err := w.writer.Write(w.buf)
+	return err
+}
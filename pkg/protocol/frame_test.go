@@ -0,0 +1,71 @@
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+// writes records every call to Write.
+type writes [][]byte
+
+func (w *writes) Write(p []byte) (int, error) {
+	*w = append(*w, bytes.Clone(p))
+	return len(p), nil
+}
+
+func TestFrameRoundTrip(t *testing.T) {
+	var w writes
+	if err := NewFrameWriter(&w).WriteFrame([]byte{1, 2}, []byte{3, 4, 5}); err != nil {
+		t.Fatal(err)
+	}
+	if len(w) != 1 {
+		t.Fatalf("frame written with %d calls to Write, want 1", len(w))
+	}
+	if want := []byte{0, 0, 0, 5, 1, 2, 3, 4, 5}; !bytes.Equal(w[0], want) {
+		t.Errorf("frame = %x, want %x", w[0], want)
+	}
+
+	r := NewFrameReader(bytes.NewReader(w[0]), 0)
+	frame, err := r.ReadFrame()
+	if err != nil || !bytes.Equal(frame, []byte{1, 2, 3, 4, 5}) {
+		t.Errorf("ReadFrame() = %x, %v", frame, err)
+	}
+	if _, err := r.ReadFrame(); err != io.EOF {
+		t.Errorf("ReadFrame() at the end = %v, want io.EOF", err)
+	}
+}
+
+func TestFrameReaderErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		check func(error) bool
+	}{
+		{"too large", []byte{0, 0, 0, 9, 1}, func(err error) bool {
+			var tooLarge *FrameTooLargeError
+			return errors.As(err, &tooLarge) && tooLarge.Size == 9 && tooLarge.MaxSize == 8
+		}},
+		{"negative size", []byte{0xff, 0xff, 0xff, 0xfe}, func(err error) bool {
+			return errors.Is(err, ErrInvalidFrameSize)
+		}},
+		{"truncated body", []byte{0, 0, 0, 4, 1, 2}, func(err error) bool {
+			return err == io.ErrUnexpectedEOF
+		}},
+		{"empty body", []byte{0, 0, 0, 4}, func(err error) bool {
+			return err == io.ErrUnexpectedEOF
+		}},
+		{"truncated size", []byte{0, 0}, func(err error) bool {
+			return err == io.ErrUnexpectedEOF
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFrameReader(bytes.NewReader(tt.input), 8).ReadFrame()
+			if !tt.check(err) {
+				t.Errorf("ReadFrame() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package protocol
+
+// IsFlexible reports whether version of the API uses the flexible (KIP-482) encoding.
+func IsFlexible(key ApiKey, version int) bool {
+	api, ok := Lookup(key)
+	return ok && api.IsFlexible(version)
+}
+
+// RequestHeaderVersion returns the version of the request header used by version of the API.
+func RequestHeaderVersion(key ApiKey, version int) int {
+	switch {
+	case IsFlexible(key, version):
+		return 2
+	case key == ControlledShutdown && version == 0:
+		// ControlledShutdown v0 predates the client id
+		return 0
+	default:
+		return 1
+	}
+}
+
+// ResponseHeaderVersion returns the version of the response header used by version of the API.
+func ResponseHeaderVersion(key ApiKey, version int) int {
+	switch {
+	case key == ApiVersions:
+		// ApiVersionsResponse always uses v0 so that clients can read it before they know which
+		// versions the broker supports.
+		return 0
+	case IsFlexible(key, version):
+		return 1
+	default:
+		return 0
+	}
+}
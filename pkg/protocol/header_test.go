@@ -0,0 +1,38 @@
+package protocol_test
+
+import (
+	"testing"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	_ "github.com/ethanmoffat/kafka-protocol/pkg/protocol/messages"
+)
+
+func TestHeaderVersion(t *testing.T) {
+	tests := []struct {
+		key      protocol.ApiKey
+		version  int
+		request  int
+		response int
+	}{
+		{protocol.ControlledShutdown, 0, 0, 0},
+		{protocol.ControlledShutdown, 1, 1, 0},
+		{protocol.ControlledShutdown, 3, 2, 1},
+		{protocol.ApiVersions, 0, 1, 0},
+		{protocol.ApiVersions, 3, 2, 0},
+		{protocol.Produce, 8, 1, 0},
+		{protocol.Produce, 9, 2, 1},
+		{protocol.Metadata, 0, 1, 0},
+		{protocol.Metadata, 9, 2, 1},
+		{protocol.SaslHandshake, 1, 1, 0},
+		// an unknown API is never flexible
+		{protocol.ApiKey(-1), 5, 1, 0},
+	}
+	for _, tt := range tests {
+		if got := protocol.RequestHeaderVersion(tt.key, tt.version); got != tt.request {
+			t.Errorf("RequestHeaderVersion(%v, %d) = %d, want %d", tt.key, tt.version, got, tt.request)
+		}
+		if got := protocol.ResponseHeaderVersion(tt.key, tt.version); got != tt.response {
+			t.Errorf("ResponseHeaderVersion(%v, %d) = %d, want %d", tt.key, tt.version, got, tt.response)
+		}
+	}
+}
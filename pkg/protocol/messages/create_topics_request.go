@@ -0,0 +1,544 @@
+// Code generated by protocol-gen-kafka from CreateTopicsRequest.json. DO NOT EDIT.
+
+package messages
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+)
+
+// CreateTopicsRequest is the request for ApiKey 19.
+//
+// Valid versions: 0-7. Flexible versions: 5+.
+type CreateTopicsRequest struct {
+	version       int
+	correlationId int
+
+	// An array of topics to attempt to create.
+	Topics []CreateTopicsRequestTopic
+	// How long to wait in milliseconds before timing out the request.
+	TimeoutMs int32
+	// Makes this request a dry-run; everything is validated but no topics are actually created.
+	ValidateOnly bool
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+func init() {
+	protocol.RegisterRequest(protocol.ApiKey(19), 0, 7, 5, func(version int) protocol.Message {
+		return NewCreateTopicsRequest(version)
+	})
+}
+
+func NewCreateTopicsRequest(version int) *CreateTopicsRequest {
+	m := &CreateTopicsRequest{version: version}
+	m.SetDefaults()
+	return m
+}
+
+func (m *CreateTopicsRequest) ApiKey() protocol.ApiKey {
+	return protocol.ApiKey(19)
+}
+
+func (m *CreateTopicsRequest) Version() int {
+	return m.version
+}
+
+func (m *CreateTopicsRequest) SetVersion(version int) {
+	m.version = version
+}
+
+func (m *CreateTopicsRequest) CorrelationId() int {
+	return m.correlationId
+}
+
+func (m *CreateTopicsRequest) SetCorrelationId(correlationId int) {
+	m.correlationId = correlationId
+}
+
+// Marshal encodes the message at its version. It returns nil if the message cannot be
+// encoded; use Encode to get the error.
+func (m *CreateTopicsRequest) Marshal() []byte {
+	var buf bytes.Buffer
+	if err := m.Encode(protocol.NewMessageWriter(&buf)); err != nil {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+// Unmarshal decodes the message at its version.
+func (m *CreateTopicsRequest) Unmarshal(b []byte) error {
+	return m.Decode(protocol.NewMessageReader(bytes.NewReader(b)))
+}
+
+func (m *CreateTopicsRequest) Encode(w *protocol.MessageWriter) error {
+	if m.version < 0 || m.version > 7 {
+		return fmt.Errorf("%w: CreateTopicsRequest v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.encode(w, m.version)
+}
+
+func (m *CreateTopicsRequest) Decode(r *protocol.MessageReader) error {
+	if m.version < 0 || m.version > 7 {
+		return fmt.Errorf("%w: CreateTopicsRequest v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.decode(r, m.version)
+}
+
+// SetDefaults resets all fields of CreateTopicsRequest to their default values.
+func (m *CreateTopicsRequest) SetDefaults() {
+	*m = CreateTopicsRequest{version: m.version, correlationId: m.correlationId, TimeoutMs: 60000}
+}
+
+func (m *CreateTopicsRequest) encode(w *protocol.MessageWriter, version int) error {
+	if version <= 4 {
+		if err := w.WriteArrayLength(len(m.Topics)); err != nil {
+			return err
+		}
+		for i := range m.Topics {
+			if err := m.Topics[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 5 {
+		if err := w.WriteCompactArrayLength(len(m.Topics)); err != nil {
+			return err
+		}
+		for i := range m.Topics {
+			if err := m.Topics[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if err := w.WriteInt32(m.TimeoutMs); err != nil {
+		return err
+	}
+	if version >= 1 {
+		if err := w.WriteBool(m.ValidateOnly); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *CreateTopicsRequest) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version <= 4 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Topics was serialized as null")
+		} else {
+			m.Topics = make([]CreateTopicsRequestTopic, 0)
+			for i := 0; i < n; i++ {
+				var e CreateTopicsRequestTopic
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Topics = append(m.Topics, e)
+			}
+		}
+	} else if version >= 5 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Topics was serialized as null")
+		} else {
+			m.Topics = make([]CreateTopicsRequestTopic, 0)
+			for i := 0; i < n; i++ {
+				var e CreateTopicsRequestTopic
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Topics = append(m.Topics, e)
+			}
+		}
+	}
+	if m.TimeoutMs, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version >= 1 {
+		if m.ValidateOnly, err = r.ReadBool(); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// CreateTopicsRequestTopic is the type of CreateTopicsRequest.Topics.
+type CreateTopicsRequestTopic struct {
+	// A topic to create.
+	Topic string
+	// How many partitions to give a topic.
+	NumPartitions int32
+	// How many replicas every partition must have.
+	ReplicationFactor int16
+	// An array to manually dicate replicas and their partitions for a topic.
+	ReplicaAssignment []CreateTopicsRequestTopicReplicaAssignment
+	// An array of key value config pairs for a topic.
+	Configs []CreateTopicsRequestTopicConfig
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of CreateTopicsRequestTopic to their default values.
+func (m *CreateTopicsRequestTopic) SetDefaults() {
+	*m = CreateTopicsRequestTopic{}
+}
+
+func (m *CreateTopicsRequestTopic) encode(w *protocol.MessageWriter, version int) error {
+	if version <= 4 {
+		if err := w.WriteString(m.Topic); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if err := w.WriteCompactString(m.Topic); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteInt32(m.NumPartitions); err != nil {
+		return err
+	}
+	if err := w.WriteInt16(m.ReplicationFactor); err != nil {
+		return err
+	}
+	if version <= 4 {
+		if err := w.WriteArrayLength(len(m.ReplicaAssignment)); err != nil {
+			return err
+		}
+		for i := range m.ReplicaAssignment {
+			if err := m.ReplicaAssignment[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 5 {
+		if err := w.WriteCompactArrayLength(len(m.ReplicaAssignment)); err != nil {
+			return err
+		}
+		for i := range m.ReplicaAssignment {
+			if err := m.ReplicaAssignment[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteArrayLength(len(m.Configs)); err != nil {
+			return err
+		}
+		for i := range m.Configs {
+			if err := m.Configs[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 5 {
+		if err := w.WriteCompactArrayLength(len(m.Configs)); err != nil {
+			return err
+		}
+		for i := range m.Configs {
+			if err := m.Configs[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 5 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *CreateTopicsRequestTopic) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version <= 4 {
+		if m.Topic, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if m.Topic, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if m.NumPartitions, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if m.ReplicationFactor, err = r.ReadInt16(); err != nil {
+		return err
+	}
+	if version <= 4 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field ReplicaAssignment was serialized as null")
+		} else {
+			m.ReplicaAssignment = make([]CreateTopicsRequestTopicReplicaAssignment, 0)
+			for i := 0; i < n; i++ {
+				var e CreateTopicsRequestTopicReplicaAssignment
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.ReplicaAssignment = append(m.ReplicaAssignment, e)
+			}
+		}
+	} else if version >= 5 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field ReplicaAssignment was serialized as null")
+		} else {
+			m.ReplicaAssignment = make([]CreateTopicsRequestTopicReplicaAssignment, 0)
+			for i := 0; i < n; i++ {
+				var e CreateTopicsRequestTopicReplicaAssignment
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.ReplicaAssignment = append(m.ReplicaAssignment, e)
+			}
+		}
+	}
+	if version <= 4 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Configs was serialized as null")
+		} else {
+			m.Configs = make([]CreateTopicsRequestTopicConfig, 0)
+			for i := 0; i < n; i++ {
+				var e CreateTopicsRequestTopicConfig
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Configs = append(m.Configs, e)
+			}
+		}
+	} else if version >= 5 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Configs was serialized as null")
+		} else {
+			m.Configs = make([]CreateTopicsRequestTopicConfig, 0)
+			for i := 0; i < n; i++ {
+				var e CreateTopicsRequestTopicConfig
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Configs = append(m.Configs, e)
+			}
+		}
+	}
+	if version >= 5 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// CreateTopicsRequestTopicReplicaAssignment is the type of CreateTopicsRequestTopic.ReplicaAssignment.
+type CreateTopicsRequestTopicReplicaAssignment struct {
+	// A partition to create.
+	Partition int32
+	// Broker IDs the partition must exist on.
+	Replicas []int32
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of CreateTopicsRequestTopicReplicaAssignment to their default values.
+func (m *CreateTopicsRequestTopicReplicaAssignment) SetDefaults() {
+	*m = CreateTopicsRequestTopicReplicaAssignment{}
+}
+
+func (m *CreateTopicsRequestTopicReplicaAssignment) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt32(m.Partition); err != nil {
+		return err
+	}
+	if version <= 4 {
+		if err := w.WriteArrayLength(len(m.Replicas)); err != nil {
+			return err
+		}
+		for i := range m.Replicas {
+			if err := w.WriteInt32(m.Replicas[i]); err != nil {
+				return err
+			}
+		}
+	} else if version >= 5 {
+		if err := w.WriteCompactArrayLength(len(m.Replicas)); err != nil {
+			return err
+		}
+		for i := range m.Replicas {
+			if err := w.WriteInt32(m.Replicas[i]); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 5 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *CreateTopicsRequestTopicReplicaAssignment) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.Partition, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version <= 4 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Replicas was serialized as null")
+		} else {
+			m.Replicas = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.Replicas = append(m.Replicas, e)
+			}
+		}
+	} else if version >= 5 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Replicas was serialized as null")
+		} else {
+			m.Replicas = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.Replicas = append(m.Replicas, e)
+			}
+		}
+	}
+	if version >= 5 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// CreateTopicsRequestTopicConfig is the type of CreateTopicsRequestTopic.Configs.
+type CreateTopicsRequestTopicConfig struct {
+	// A topic level config key (e.g.
+	Name string
+	// A topic level config value (e.g.
+	Value *string
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of CreateTopicsRequestTopicConfig to their default values.
+func (m *CreateTopicsRequestTopicConfig) SetDefaults() {
+	*m = CreateTopicsRequestTopicConfig{}
+}
+
+func (m *CreateTopicsRequestTopicConfig) encode(w *protocol.MessageWriter, version int) error {
+	if version <= 4 {
+		if err := w.WriteString(m.Name); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if err := w.WriteCompactString(m.Name); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteNullableString(m.Value); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if err := w.WriteCompactNullableString(m.Value); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *CreateTopicsRequestTopicConfig) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version <= 4 {
+		if m.Name, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if m.Name, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if m.Value, err = r.ReadNullableString(); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if m.Value, err = r.ReadCompactNullableString(); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
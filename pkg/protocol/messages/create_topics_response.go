@@ -0,0 +1,465 @@
+// Code generated by protocol-gen-kafka from CreateTopicsResponse.json. DO NOT EDIT.
+
+package messages
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/google/uuid"
+)
+
+// CreateTopicsResponse is the response for ApiKey 19.
+//
+// Valid versions: 0-7. Flexible versions: 5+.
+type CreateTopicsResponse struct {
+	version       int
+	correlationId int
+
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs int32
+	// Topics contains responses to the requested topic creations.
+	Topics []CreateTopicsResponseTopic
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+func init() {
+	protocol.RegisterResponse(protocol.ApiKey(19), func(version int) protocol.Message {
+		return NewCreateTopicsResponse(version)
+	})
+}
+
+func NewCreateTopicsResponse(version int) *CreateTopicsResponse {
+	m := &CreateTopicsResponse{version: version}
+	m.SetDefaults()
+	return m
+}
+
+func (m *CreateTopicsResponse) ApiKey() protocol.ApiKey {
+	return protocol.ApiKey(19)
+}
+
+func (m *CreateTopicsResponse) Version() int {
+	return m.version
+}
+
+func (m *CreateTopicsResponse) SetVersion(version int) {
+	m.version = version
+}
+
+func (m *CreateTopicsResponse) CorrelationId() int {
+	return m.correlationId
+}
+
+func (m *CreateTopicsResponse) SetCorrelationId(correlationId int) {
+	m.correlationId = correlationId
+}
+
+// Marshal encodes the message at its version. It returns nil if the message cannot be
+// encoded; use Encode to get the error.
+func (m *CreateTopicsResponse) Marshal() []byte {
+	var buf bytes.Buffer
+	if err := m.Encode(protocol.NewMessageWriter(&buf)); err != nil {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+// Unmarshal decodes the message at its version.
+func (m *CreateTopicsResponse) Unmarshal(b []byte) error {
+	return m.Decode(protocol.NewMessageReader(bytes.NewReader(b)))
+}
+
+func (m *CreateTopicsResponse) Encode(w *protocol.MessageWriter) error {
+	if m.version < 0 || m.version > 7 {
+		return fmt.Errorf("%w: CreateTopicsResponse v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.encode(w, m.version)
+}
+
+func (m *CreateTopicsResponse) Decode(r *protocol.MessageReader) error {
+	if m.version < 0 || m.version > 7 {
+		return fmt.Errorf("%w: CreateTopicsResponse v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.decode(r, m.version)
+}
+
+// SetDefaults resets all fields of CreateTopicsResponse to their default values.
+func (m *CreateTopicsResponse) SetDefaults() {
+	*m = CreateTopicsResponse{version: m.version, correlationId: m.correlationId}
+}
+
+func (m *CreateTopicsResponse) encode(w *protocol.MessageWriter, version int) error {
+	if version >= 2 {
+		if err := w.WriteInt32(m.ThrottleTimeMs); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteArrayLength(len(m.Topics)); err != nil {
+			return err
+		}
+		for i := range m.Topics {
+			if err := m.Topics[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 5 {
+		if err := w.WriteCompactArrayLength(len(m.Topics)); err != nil {
+			return err
+		}
+		for i := range m.Topics {
+			if err := m.Topics[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 5 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *CreateTopicsResponse) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version >= 2 {
+		if m.ThrottleTimeMs, err = r.ReadInt32(); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Topics was serialized as null")
+		} else {
+			m.Topics = make([]CreateTopicsResponseTopic, 0)
+			for i := 0; i < n; i++ {
+				var e CreateTopicsResponseTopic
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Topics = append(m.Topics, e)
+			}
+		}
+	} else if version >= 5 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Topics was serialized as null")
+		} else {
+			m.Topics = make([]CreateTopicsResponseTopic, 0)
+			for i := 0; i < n; i++ {
+				var e CreateTopicsResponseTopic
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Topics = append(m.Topics, e)
+			}
+		}
+	}
+	if version >= 5 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// CreateTopicsResponseTopic is the type of CreateTopicsResponse.Topics.
+type CreateTopicsResponseTopic struct {
+	// The topic this response corresponds to.
+	Topic string
+	// The unique topic ID.
+	TopicId uuid.UUID
+	// The error code for an individual topic creation.
+	ErrorCode int16
+	// An informative message if the topic creation failed.
+	ErrorMessage *string
+	// Non-zero if configs are unable to be returned.
+	ConfigErrorCode int16
+	// How many partitions were created for this topic.
+	NumPartitions int32
+	// How many replicas every partition has for this topic.
+	ReplicationFactor int16
+	// Configs contains this topic's configuration.
+	Configs []CreateTopicsResponseTopicConfig
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of CreateTopicsResponseTopic to their default values.
+func (m *CreateTopicsResponseTopic) SetDefaults() {
+	*m = CreateTopicsResponseTopic{NumPartitions: -1, ReplicationFactor: -1}
+}
+
+func (m *CreateTopicsResponseTopic) encode(w *protocol.MessageWriter, version int) error {
+	if version <= 4 {
+		if err := w.WriteString(m.Topic); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if err := w.WriteCompactString(m.Topic); err != nil {
+			return err
+		}
+	}
+	if version == 7 {
+		if err := w.WriteUuid(m.TopicId); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteInt16(m.ErrorCode); err != nil {
+		return err
+	}
+	if version >= 1 && version <= 4 {
+		if err := w.WriteNullableString(m.ErrorMessage); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if err := w.WriteCompactNullableString(m.ErrorMessage); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		if err := w.WriteInt32(m.NumPartitions); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		if err := w.WriteInt16(m.ReplicationFactor); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		if m.Configs == nil {
+			if err := w.WriteCompactArrayLength(-1); err != nil {
+				return err
+			}
+		} else {
+			if err := w.WriteCompactArrayLength(len(m.Configs)); err != nil {
+				return err
+			}
+			for i := range m.Configs {
+				if err := m.Configs[i].encode(w, version); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if version >= 5 {
+		tagged := make([]protocol.TaggedField, 0, 1+len(m.UnknownTaggedFields))
+		if m.ConfigErrorCode != 0 {
+			f, err := protocol.NewTaggedField(0, func(w *protocol.MessageWriter) error {
+				if err := w.WriteInt16(m.ConfigErrorCode); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			tagged = append(tagged, f)
+		}
+		tagged = append(tagged, m.UnknownTaggedFields...)
+		if err := w.WriteTaggedFields(tagged); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *CreateTopicsResponseTopic) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version <= 4 {
+		if m.Topic, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if m.Topic, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version == 7 {
+		if m.TopicId, err = r.ReadUuid(); err != nil {
+			return err
+		}
+	}
+	if m.ErrorCode, err = r.ReadInt16(); err != nil {
+		return err
+	}
+	if version >= 1 && version <= 4 {
+		if m.ErrorMessage, err = r.ReadNullableString(); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if m.ErrorMessage, err = r.ReadCompactNullableString(); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		if m.NumPartitions, err = r.ReadInt32(); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		if m.ReplicationFactor, err = r.ReadInt16(); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			m.Configs = nil
+		} else {
+			m.Configs = make([]CreateTopicsResponseTopicConfig, 0)
+			for i := 0; i < n; i++ {
+				var e CreateTopicsResponseTopicConfig
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Configs = append(m.Configs, e)
+			}
+		}
+	}
+	if version >= 5 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			case f.Tag == 0:
+				if err := func(r *protocol.MessageReader) (err error) {
+					if m.ConfigErrorCode, err = r.ReadInt16(); err != nil {
+						return err
+					}
+					return nil
+				}(f.Reader()); err != nil {
+					return err
+				}
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// CreateTopicsResponseTopicConfig is the type of CreateTopicsResponseTopic.Configs.
+type CreateTopicsResponseTopicConfig struct {
+	// The configuration name (e.g.
+	Name string
+	// The value for this config key.
+	Value *string
+	// ReadOnly signifies whether this is not a dynamic config option.
+	ReadOnly bool
+	// Where this config entry is from.
+	Source int8
+	// IsSensitive signifies whether this is a sensitive config key, which is either a password or an unknown type.
+	IsSensitive bool
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of CreateTopicsResponseTopicConfig to their default values.
+func (m *CreateTopicsResponseTopicConfig) SetDefaults() {
+	*m = CreateTopicsResponseTopicConfig{Source: -1}
+}
+
+func (m *CreateTopicsResponseTopicConfig) encode(w *protocol.MessageWriter, version int) error {
+	if version <= 4 {
+		if err := w.WriteString(m.Name); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if err := w.WriteCompactString(m.Name); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteNullableString(m.Value); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if err := w.WriteCompactNullableString(m.Value); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteBool(m.ReadOnly); err != nil {
+		return err
+	}
+	if err := w.WriteInt8(m.Source); err != nil {
+		return err
+	}
+	if err := w.WriteBool(m.IsSensitive); err != nil {
+		return err
+	}
+	if version >= 5 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *CreateTopicsResponseTopicConfig) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version <= 4 {
+		if m.Name, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if m.Name, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if m.Value, err = r.ReadNullableString(); err != nil {
+			return err
+		}
+	} else if version >= 5 {
+		if m.Value, err = r.ReadCompactNullableString(); err != nil {
+			return err
+		}
+	}
+	if m.ReadOnly, err = r.ReadBool(); err != nil {
+		return err
+	}
+	if m.Source, err = r.ReadInt8(); err != nil {
+		return err
+	}
+	if m.IsSensitive, err = r.ReadBool(); err != nil {
+		return err
+	}
+	if version >= 5 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,609 @@
+// Code generated by protocol-gen-kafka from DescribeConfigsResponse.json. DO NOT EDIT.
+
+package messages
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+)
+
+// DescribeConfigsResponse is the response for ApiKey 32.
+//
+// Valid versions: 0-4. Flexible versions: 4+.
+type DescribeConfigsResponse struct {
+	version       int
+	correlationId int
+
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs int32
+	// Responses for each resource in the describe config request.
+	Resources []DescribeConfigsResponseResource
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+func init() {
+	protocol.RegisterResponse(protocol.ApiKey(32), func(version int) protocol.Message {
+		return NewDescribeConfigsResponse(version)
+	})
+}
+
+func NewDescribeConfigsResponse(version int) *DescribeConfigsResponse {
+	m := &DescribeConfigsResponse{version: version}
+	m.SetDefaults()
+	return m
+}
+
+func (m *DescribeConfigsResponse) ApiKey() protocol.ApiKey {
+	return protocol.ApiKey(32)
+}
+
+func (m *DescribeConfigsResponse) Version() int {
+	return m.version
+}
+
+func (m *DescribeConfigsResponse) SetVersion(version int) {
+	m.version = version
+}
+
+func (m *DescribeConfigsResponse) CorrelationId() int {
+	return m.correlationId
+}
+
+func (m *DescribeConfigsResponse) SetCorrelationId(correlationId int) {
+	m.correlationId = correlationId
+}
+
+// Marshal encodes the message at its version. It returns nil if the message cannot be
+// encoded; use Encode to get the error.
+func (m *DescribeConfigsResponse) Marshal() []byte {
+	var buf bytes.Buffer
+	if err := m.Encode(protocol.NewMessageWriter(&buf)); err != nil {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+// Unmarshal decodes the message at its version.
+func (m *DescribeConfigsResponse) Unmarshal(b []byte) error {
+	return m.Decode(protocol.NewMessageReader(bytes.NewReader(b)))
+}
+
+func (m *DescribeConfigsResponse) Encode(w *protocol.MessageWriter) error {
+	if m.version < 0 || m.version > 4 {
+		return fmt.Errorf("%w: DescribeConfigsResponse v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.encode(w, m.version)
+}
+
+func (m *DescribeConfigsResponse) Decode(r *protocol.MessageReader) error {
+	if m.version < 0 || m.version > 4 {
+		return fmt.Errorf("%w: DescribeConfigsResponse v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.decode(r, m.version)
+}
+
+// SetDefaults resets all fields of DescribeConfigsResponse to their default values.
+func (m *DescribeConfigsResponse) SetDefaults() {
+	*m = DescribeConfigsResponse{version: m.version, correlationId: m.correlationId}
+}
+
+func (m *DescribeConfigsResponse) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt32(m.ThrottleTimeMs); err != nil {
+		return err
+	}
+	if version <= 3 {
+		if err := w.WriteArrayLength(len(m.Resources)); err != nil {
+			return err
+		}
+		for i := range m.Resources {
+			if err := m.Resources[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version == 4 {
+		if err := w.WriteCompactArrayLength(len(m.Resources)); err != nil {
+			return err
+		}
+		for i := range m.Resources {
+			if err := m.Resources[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version == 4 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DescribeConfigsResponse) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.ThrottleTimeMs, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version <= 3 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Resources was serialized as null")
+		} else {
+			m.Resources = make([]DescribeConfigsResponseResource, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeConfigsResponseResource
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Resources = append(m.Resources, e)
+			}
+		}
+	} else if version == 4 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Resources was serialized as null")
+		} else {
+			m.Resources = make([]DescribeConfigsResponseResource, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeConfigsResponseResource
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Resources = append(m.Resources, e)
+			}
+		}
+	}
+	if version == 4 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// DescribeConfigsResponseResource is the type of DescribeConfigsResponse.Resources.
+type DescribeConfigsResponseResource struct {
+	// The error code returned for describing configs.
+	ErrorCode int16
+	// An informative message if the describe config failed.
+	ErrorMessage *string
+	// The enum corresponding to the type of described config.
+	ResourceType int8
+	// The name corresponding to the describe config request.
+	ResourceName string
+	// Configs contains information about key/value config pairs for the requested resource.
+	Configs []DescribeConfigsResponseResourceConfig
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of DescribeConfigsResponseResource to their default values.
+func (m *DescribeConfigsResponseResource) SetDefaults() {
+	*m = DescribeConfigsResponseResource{}
+}
+
+func (m *DescribeConfigsResponseResource) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt16(m.ErrorCode); err != nil {
+		return err
+	}
+	if version <= 3 {
+		if err := w.WriteNullableString(m.ErrorMessage); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if err := w.WriteCompactNullableString(m.ErrorMessage); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteInt8(m.ResourceType); err != nil {
+		return err
+	}
+	if version <= 3 {
+		if err := w.WriteString(m.ResourceName); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if err := w.WriteCompactString(m.ResourceName); err != nil {
+			return err
+		}
+	}
+	if version <= 3 {
+		if err := w.WriteArrayLength(len(m.Configs)); err != nil {
+			return err
+		}
+		for i := range m.Configs {
+			if err := m.Configs[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version == 4 {
+		if err := w.WriteCompactArrayLength(len(m.Configs)); err != nil {
+			return err
+		}
+		for i := range m.Configs {
+			if err := m.Configs[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version == 4 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DescribeConfigsResponseResource) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.ErrorCode, err = r.ReadInt16(); err != nil {
+		return err
+	}
+	if version <= 3 {
+		if m.ErrorMessage, err = r.ReadNullableString(); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if m.ErrorMessage, err = r.ReadCompactNullableString(); err != nil {
+			return err
+		}
+	}
+	if m.ResourceType, err = r.ReadInt8(); err != nil {
+		return err
+	}
+	if version <= 3 {
+		if m.ResourceName, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if m.ResourceName, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version <= 3 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Configs was serialized as null")
+		} else {
+			m.Configs = make([]DescribeConfigsResponseResourceConfig, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeConfigsResponseResourceConfig
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Configs = append(m.Configs, e)
+			}
+		}
+	} else if version == 4 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Configs was serialized as null")
+		} else {
+			m.Configs = make([]DescribeConfigsResponseResourceConfig, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeConfigsResponseResourceConfig
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Configs = append(m.Configs, e)
+			}
+		}
+	}
+	if version == 4 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// DescribeConfigsResponseResourceConfig is the type of DescribeConfigsResponseResource.Configs.
+type DescribeConfigsResponseResourceConfig struct {
+	// A key this entry corresponds to (e.g.
+	Name string
+	// The value for this config key.
+	Value *string
+	// ReadOnly signifies whether this is not a dynamic config option.
+	ReadOnly bool
+	// Whether this is a default config option.
+	IsDefault bool
+	// Where this config entry is from.
+	Source int8
+	// IsSensitive signifies whether this is a sensitive config key, which is either a password or an unknown type.
+	IsSensitive bool
+	// ConfigSynonyms contains fallback key/value pairs for this config entry, in order of preference.
+	ConfigSynonyms []DescribeConfigsResponseResourceConfigConfigSynonym
+	// ConfigType specifies the configuration data type.
+	ConfigType int8
+	// Optional documentation for the config entry.
+	Documentation *string
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of DescribeConfigsResponseResourceConfig to their default values.
+func (m *DescribeConfigsResponseResourceConfig) SetDefaults() {
+	*m = DescribeConfigsResponseResourceConfig{Source: -1}
+}
+
+func (m *DescribeConfigsResponseResourceConfig) encode(w *protocol.MessageWriter, version int) error {
+	if version <= 3 {
+		if err := w.WriteString(m.Name); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if err := w.WriteCompactString(m.Name); err != nil {
+			return err
+		}
+	}
+	if version <= 3 {
+		if err := w.WriteNullableString(m.Value); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if err := w.WriteCompactNullableString(m.Value); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteBool(m.ReadOnly); err != nil {
+		return err
+	}
+	if version == 0 {
+		if err := w.WriteBool(m.IsDefault); err != nil {
+			return err
+		}
+	}
+	if version >= 1 {
+		if err := w.WriteInt8(m.Source); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteBool(m.IsSensitive); err != nil {
+		return err
+	}
+	if version >= 1 && version <= 3 {
+		if err := w.WriteArrayLength(len(m.ConfigSynonyms)); err != nil {
+			return err
+		}
+		for i := range m.ConfigSynonyms {
+			if err := m.ConfigSynonyms[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version == 4 {
+		if err := w.WriteCompactArrayLength(len(m.ConfigSynonyms)); err != nil {
+			return err
+		}
+		for i := range m.ConfigSynonyms {
+			if err := m.ConfigSynonyms[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 3 {
+		if err := w.WriteInt8(m.ConfigType); err != nil {
+			return err
+		}
+	}
+	if version == 3 {
+		if err := w.WriteNullableString(m.Documentation); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if err := w.WriteCompactNullableString(m.Documentation); err != nil {
+			return err
+		}
+	}
+	if version == 4 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DescribeConfigsResponseResourceConfig) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version <= 3 {
+		if m.Name, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if m.Name, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version <= 3 {
+		if m.Value, err = r.ReadNullableString(); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if m.Value, err = r.ReadCompactNullableString(); err != nil {
+			return err
+		}
+	}
+	if m.ReadOnly, err = r.ReadBool(); err != nil {
+		return err
+	}
+	if version == 0 {
+		if m.IsDefault, err = r.ReadBool(); err != nil {
+			return err
+		}
+	}
+	if version >= 1 {
+		if m.Source, err = r.ReadInt8(); err != nil {
+			return err
+		}
+	}
+	if m.IsSensitive, err = r.ReadBool(); err != nil {
+		return err
+	}
+	if version >= 1 && version <= 3 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field ConfigSynonyms was serialized as null")
+		} else {
+			m.ConfigSynonyms = make([]DescribeConfigsResponseResourceConfigConfigSynonym, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeConfigsResponseResourceConfigConfigSynonym
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.ConfigSynonyms = append(m.ConfigSynonyms, e)
+			}
+		}
+	} else if version == 4 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field ConfigSynonyms was serialized as null")
+		} else {
+			m.ConfigSynonyms = make([]DescribeConfigsResponseResourceConfigConfigSynonym, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeConfigsResponseResourceConfigConfigSynonym
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.ConfigSynonyms = append(m.ConfigSynonyms, e)
+			}
+		}
+	}
+	if version >= 3 {
+		if m.ConfigType, err = r.ReadInt8(); err != nil {
+			return err
+		}
+	}
+	if version == 3 {
+		if m.Documentation, err = r.ReadNullableString(); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if m.Documentation, err = r.ReadCompactNullableString(); err != nil {
+			return err
+		}
+	}
+	if version == 4 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// DescribeConfigsResponseResourceConfigConfigSynonym is the type of DescribeConfigsResponseResourceConfig.ConfigSynonyms.
+type DescribeConfigsResponseResourceConfigConfigSynonym struct {
+	Name   string
+	Value  *string
+	Source int8
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of DescribeConfigsResponseResourceConfigConfigSynonym to their default values.
+func (m *DescribeConfigsResponseResourceConfigConfigSynonym) SetDefaults() {
+	*m = DescribeConfigsResponseResourceConfigConfigSynonym{}
+}
+
+func (m *DescribeConfigsResponseResourceConfigConfigSynonym) encode(w *protocol.MessageWriter, version int) error {
+	if version <= 3 {
+		if err := w.WriteString(m.Name); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if err := w.WriteCompactString(m.Name); err != nil {
+			return err
+		}
+	}
+	if version <= 3 {
+		if err := w.WriteNullableString(m.Value); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if err := w.WriteCompactNullableString(m.Value); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteInt8(m.Source); err != nil {
+		return err
+	}
+	if version == 4 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DescribeConfigsResponseResourceConfigConfigSynonym) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version <= 3 {
+		if m.Name, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if m.Name, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version <= 3 {
+		if m.Value, err = r.ReadNullableString(); err != nil {
+			return err
+		}
+	} else if version == 4 {
+		if m.Value, err = r.ReadCompactNullableString(); err != nil {
+			return err
+		}
+	}
+	if m.Source, err = r.ReadInt8(); err != nil {
+		return err
+	}
+	if version == 4 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,529 @@
+// Code generated by protocol-gen-kafka from DescribeGroupsResponse.json. DO NOT EDIT.
+
+package messages
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+)
+
+// DescribeGroupsResponse is the response for ApiKey 15.
+//
+// Valid versions: 0-5. Flexible versions: 5+.
+type DescribeGroupsResponse struct {
+	version       int
+	correlationId int
+
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs int32
+	// An array of group metadata.
+	Groups []DescribeGroupsResponseGroup
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+func init() {
+	protocol.RegisterResponse(protocol.ApiKey(15), func(version int) protocol.Message {
+		return NewDescribeGroupsResponse(version)
+	})
+}
+
+func NewDescribeGroupsResponse(version int) *DescribeGroupsResponse {
+	m := &DescribeGroupsResponse{version: version}
+	m.SetDefaults()
+	return m
+}
+
+func (m *DescribeGroupsResponse) ApiKey() protocol.ApiKey {
+	return protocol.ApiKey(15)
+}
+
+func (m *DescribeGroupsResponse) Version() int {
+	return m.version
+}
+
+func (m *DescribeGroupsResponse) SetVersion(version int) {
+	m.version = version
+}
+
+func (m *DescribeGroupsResponse) CorrelationId() int {
+	return m.correlationId
+}
+
+func (m *DescribeGroupsResponse) SetCorrelationId(correlationId int) {
+	m.correlationId = correlationId
+}
+
+// Marshal encodes the message at its version. It returns nil if the message cannot be
+// encoded; use Encode to get the error.
+func (m *DescribeGroupsResponse) Marshal() []byte {
+	var buf bytes.Buffer
+	if err := m.Encode(protocol.NewMessageWriter(&buf)); err != nil {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+// Unmarshal decodes the message at its version.
+func (m *DescribeGroupsResponse) Unmarshal(b []byte) error {
+	return m.Decode(protocol.NewMessageReader(bytes.NewReader(b)))
+}
+
+func (m *DescribeGroupsResponse) Encode(w *protocol.MessageWriter) error {
+	if m.version < 0 || m.version > 5 {
+		return fmt.Errorf("%w: DescribeGroupsResponse v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.encode(w, m.version)
+}
+
+func (m *DescribeGroupsResponse) Decode(r *protocol.MessageReader) error {
+	if m.version < 0 || m.version > 5 {
+		return fmt.Errorf("%w: DescribeGroupsResponse v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.decode(r, m.version)
+}
+
+// SetDefaults resets all fields of DescribeGroupsResponse to their default values.
+func (m *DescribeGroupsResponse) SetDefaults() {
+	*m = DescribeGroupsResponse{version: m.version, correlationId: m.correlationId}
+}
+
+func (m *DescribeGroupsResponse) encode(w *protocol.MessageWriter, version int) error {
+	if version >= 1 {
+		if err := w.WriteInt32(m.ThrottleTimeMs); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteArrayLength(len(m.Groups)); err != nil {
+			return err
+		}
+		for i := range m.Groups {
+			if err := m.Groups[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version == 5 {
+		if err := w.WriteCompactArrayLength(len(m.Groups)); err != nil {
+			return err
+		}
+		for i := range m.Groups {
+			if err := m.Groups[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version == 5 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DescribeGroupsResponse) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version >= 1 {
+		if m.ThrottleTimeMs, err = r.ReadInt32(); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Groups was serialized as null")
+		} else {
+			m.Groups = make([]DescribeGroupsResponseGroup, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeGroupsResponseGroup
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Groups = append(m.Groups, e)
+			}
+		}
+	} else if version == 5 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Groups was serialized as null")
+		} else {
+			m.Groups = make([]DescribeGroupsResponseGroup, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeGroupsResponseGroup
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Groups = append(m.Groups, e)
+			}
+		}
+	}
+	if version == 5 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// DescribeGroupsResponseGroup is the type of DescribeGroupsResponse.Groups.
+type DescribeGroupsResponseGroup struct {
+	// The error code for an individual group in a request.
+	ErrorCode int16
+	// The id of this group.
+	Group string
+	// The state this group is in.
+	State string
+	// The "type" of protocol being used for this group.
+	ProtocolType string
+	// The agreed upon protocol for all members in this group.
+	Protocol string
+	// Members contains members in this group.
+	Members []DescribeGroupsResponseGroupMember
+	// A bitfield containing which operations the the client is allowed to perform on this group.
+	AuthorizedOperations int32
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of DescribeGroupsResponseGroup to their default values.
+func (m *DescribeGroupsResponseGroup) SetDefaults() {
+	*m = DescribeGroupsResponseGroup{AuthorizedOperations: -2147483648}
+}
+
+func (m *DescribeGroupsResponseGroup) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt16(m.ErrorCode); err != nil {
+		return err
+	}
+	if version <= 4 {
+		if err := w.WriteString(m.Group); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if err := w.WriteCompactString(m.Group); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteString(m.State); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if err := w.WriteCompactString(m.State); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteString(m.ProtocolType); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if err := w.WriteCompactString(m.ProtocolType); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteString(m.Protocol); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if err := w.WriteCompactString(m.Protocol); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteArrayLength(len(m.Members)); err != nil {
+			return err
+		}
+		for i := range m.Members {
+			if err := m.Members[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version == 5 {
+		if err := w.WriteCompactArrayLength(len(m.Members)); err != nil {
+			return err
+		}
+		for i := range m.Members {
+			if err := m.Members[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 3 {
+		if err := w.WriteInt32(m.AuthorizedOperations); err != nil {
+			return err
+		}
+	}
+	if version == 5 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DescribeGroupsResponseGroup) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.ErrorCode, err = r.ReadInt16(); err != nil {
+		return err
+	}
+	if version <= 4 {
+		if m.Group, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if m.Group, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if m.State, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if m.State, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if m.ProtocolType, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if m.ProtocolType, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if m.Protocol, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if m.Protocol, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Members was serialized as null")
+		} else {
+			m.Members = make([]DescribeGroupsResponseGroupMember, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeGroupsResponseGroupMember
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Members = append(m.Members, e)
+			}
+		}
+	} else if version == 5 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Members was serialized as null")
+		} else {
+			m.Members = make([]DescribeGroupsResponseGroupMember, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeGroupsResponseGroupMember
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Members = append(m.Members, e)
+			}
+		}
+	}
+	if version >= 3 {
+		if m.AuthorizedOperations, err = r.ReadInt32(); err != nil {
+			return err
+		}
+	}
+	if version == 5 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// DescribeGroupsResponseGroupMember is the type of DescribeGroupsResponseGroup.Members.
+type DescribeGroupsResponseGroupMember struct {
+	// The member ID of a member in this group.
+	MemberId string
+	// The instance ID of this member in the group (KIP-345).
+	InstanceId *string
+	// The client ID used by this member.
+	ClientId string
+	// The host this client is running on.
+	ClientHost string
+	// The metadata this member included when joining the group.
+	ProtocolMetadata []byte
+	// The assignment for this member in the group.
+	MemberAssignment []byte
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of DescribeGroupsResponseGroupMember to their default values.
+func (m *DescribeGroupsResponseGroupMember) SetDefaults() {
+	*m = DescribeGroupsResponseGroupMember{}
+}
+
+func (m *DescribeGroupsResponseGroupMember) encode(w *protocol.MessageWriter, version int) error {
+	if version <= 4 {
+		if err := w.WriteString(m.MemberId); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if err := w.WriteCompactString(m.MemberId); err != nil {
+			return err
+		}
+	}
+	if version == 4 {
+		if err := w.WriteNullableString(m.InstanceId); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if err := w.WriteCompactNullableString(m.InstanceId); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteString(m.ClientId); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if err := w.WriteCompactString(m.ClientId); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteString(m.ClientHost); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if err := w.WriteCompactString(m.ClientHost); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteBytes(m.ProtocolMetadata); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if err := w.WriteCompactBytes(m.ProtocolMetadata); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteBytes(m.MemberAssignment); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if err := w.WriteCompactBytes(m.MemberAssignment); err != nil {
+			return err
+		}
+	}
+	if version == 5 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DescribeGroupsResponseGroupMember) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version <= 4 {
+		if m.MemberId, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if m.MemberId, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version == 4 {
+		if m.InstanceId, err = r.ReadNullableString(); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if m.InstanceId, err = r.ReadCompactNullableString(); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if m.ClientId, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if m.ClientId, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if m.ClientHost, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if m.ClientHost, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if m.ProtocolMetadata, err = r.ReadBytes(); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if m.ProtocolMetadata, err = r.ReadCompactBytes(); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if m.MemberAssignment, err = r.ReadBytes(); err != nil {
+			return err
+		}
+	} else if version == 5 {
+		if m.MemberAssignment, err = r.ReadCompactBytes(); err != nil {
+			return err
+		}
+	}
+	if version == 5 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
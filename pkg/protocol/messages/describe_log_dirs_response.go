@@ -0,0 +1,512 @@
+// Code generated by protocol-gen-kafka from DescribeLogDirsResponse.json. DO NOT EDIT.
+
+package messages
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+)
+
+// DescribeLogDirsResponse is the response for ApiKey 35.
+//
+// Valid versions: 0-4. Flexible versions: 2+.
+type DescribeLogDirsResponse struct {
+	version       int
+	correlationId int
+
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs int32
+	// The error code, or 0 if there was no error.
+	ErrorCode int16
+	// Dirs pairs log directories with the topics and partitions that are stored in those directores.
+	Dirs []DescribeLogDirsResponseDir
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+func init() {
+	protocol.RegisterResponse(protocol.ApiKey(35), func(version int) protocol.Message {
+		return NewDescribeLogDirsResponse(version)
+	})
+}
+
+func NewDescribeLogDirsResponse(version int) *DescribeLogDirsResponse {
+	m := &DescribeLogDirsResponse{version: version}
+	m.SetDefaults()
+	return m
+}
+
+func (m *DescribeLogDirsResponse) ApiKey() protocol.ApiKey {
+	return protocol.ApiKey(35)
+}
+
+func (m *DescribeLogDirsResponse) Version() int {
+	return m.version
+}
+
+func (m *DescribeLogDirsResponse) SetVersion(version int) {
+	m.version = version
+}
+
+func (m *DescribeLogDirsResponse) CorrelationId() int {
+	return m.correlationId
+}
+
+func (m *DescribeLogDirsResponse) SetCorrelationId(correlationId int) {
+	m.correlationId = correlationId
+}
+
+// Marshal encodes the message at its version. It returns nil if the message cannot be
+// encoded; use Encode to get the error.
+func (m *DescribeLogDirsResponse) Marshal() []byte {
+	var buf bytes.Buffer
+	if err := m.Encode(protocol.NewMessageWriter(&buf)); err != nil {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+// Unmarshal decodes the message at its version.
+func (m *DescribeLogDirsResponse) Unmarshal(b []byte) error {
+	return m.Decode(protocol.NewMessageReader(bytes.NewReader(b)))
+}
+
+func (m *DescribeLogDirsResponse) Encode(w *protocol.MessageWriter) error {
+	if m.version < 0 || m.version > 4 {
+		return fmt.Errorf("%w: DescribeLogDirsResponse v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.encode(w, m.version)
+}
+
+func (m *DescribeLogDirsResponse) Decode(r *protocol.MessageReader) error {
+	if m.version < 0 || m.version > 4 {
+		return fmt.Errorf("%w: DescribeLogDirsResponse v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.decode(r, m.version)
+}
+
+// SetDefaults resets all fields of DescribeLogDirsResponse to their default values.
+func (m *DescribeLogDirsResponse) SetDefaults() {
+	*m = DescribeLogDirsResponse{version: m.version, correlationId: m.correlationId}
+}
+
+func (m *DescribeLogDirsResponse) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt32(m.ThrottleTimeMs); err != nil {
+		return err
+	}
+	if version >= 3 {
+		if err := w.WriteInt16(m.ErrorCode); err != nil {
+			return err
+		}
+	}
+	if version <= 1 {
+		if err := w.WriteArrayLength(len(m.Dirs)); err != nil {
+			return err
+		}
+		for i := range m.Dirs {
+			if err := m.Dirs[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 2 {
+		if err := w.WriteCompactArrayLength(len(m.Dirs)); err != nil {
+			return err
+		}
+		for i := range m.Dirs {
+			if err := m.Dirs[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 2 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DescribeLogDirsResponse) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.ThrottleTimeMs, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version >= 3 {
+		if m.ErrorCode, err = r.ReadInt16(); err != nil {
+			return err
+		}
+	}
+	if version <= 1 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Dirs was serialized as null")
+		} else {
+			m.Dirs = make([]DescribeLogDirsResponseDir, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeLogDirsResponseDir
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Dirs = append(m.Dirs, e)
+			}
+		}
+	} else if version >= 2 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Dirs was serialized as null")
+		} else {
+			m.Dirs = make([]DescribeLogDirsResponseDir, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeLogDirsResponseDir
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Dirs = append(m.Dirs, e)
+			}
+		}
+	}
+	if version >= 2 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// DescribeLogDirsResponseDir is the type of DescribeLogDirsResponse.Dirs.
+type DescribeLogDirsResponseDir struct {
+	// The error code returned for describing log dirs.
+	ErrorCode int16
+	// The absolute path of a log directory.
+	Dir string
+	// An array of topics within a log directory.
+	Topics []DescribeLogDirsResponseDirTopic
+	// The total size in bytes of the volume the log directory is in.
+	TotalBytes int64
+	// The usable size in bytes of the volume the log directory is in.
+	UsableBytes int64
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of DescribeLogDirsResponseDir to their default values.
+func (m *DescribeLogDirsResponseDir) SetDefaults() {
+	*m = DescribeLogDirsResponseDir{TotalBytes: -1, UsableBytes: -1}
+}
+
+func (m *DescribeLogDirsResponseDir) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt16(m.ErrorCode); err != nil {
+		return err
+	}
+	if version <= 1 {
+		if err := w.WriteString(m.Dir); err != nil {
+			return err
+		}
+	} else if version >= 2 {
+		if err := w.WriteCompactString(m.Dir); err != nil {
+			return err
+		}
+	}
+	if version <= 1 {
+		if err := w.WriteArrayLength(len(m.Topics)); err != nil {
+			return err
+		}
+		for i := range m.Topics {
+			if err := m.Topics[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 2 {
+		if err := w.WriteCompactArrayLength(len(m.Topics)); err != nil {
+			return err
+		}
+		for i := range m.Topics {
+			if err := m.Topics[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version == 4 {
+		if err := w.WriteInt64(m.TotalBytes); err != nil {
+			return err
+		}
+	}
+	if version == 4 {
+		if err := w.WriteInt64(m.UsableBytes); err != nil {
+			return err
+		}
+	}
+	if version >= 2 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DescribeLogDirsResponseDir) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.ErrorCode, err = r.ReadInt16(); err != nil {
+		return err
+	}
+	if version <= 1 {
+		if m.Dir, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version >= 2 {
+		if m.Dir, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version <= 1 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Topics was serialized as null")
+		} else {
+			m.Topics = make([]DescribeLogDirsResponseDirTopic, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeLogDirsResponseDirTopic
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Topics = append(m.Topics, e)
+			}
+		}
+	} else if version >= 2 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Topics was serialized as null")
+		} else {
+			m.Topics = make([]DescribeLogDirsResponseDirTopic, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeLogDirsResponseDirTopic
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Topics = append(m.Topics, e)
+			}
+		}
+	}
+	if version == 4 {
+		if m.TotalBytes, err = r.ReadInt64(); err != nil {
+			return err
+		}
+	}
+	if version == 4 {
+		if m.UsableBytes, err = r.ReadInt64(); err != nil {
+			return err
+		}
+	}
+	if version >= 2 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// DescribeLogDirsResponseDirTopic is the type of DescribeLogDirsResponseDir.Topics.
+type DescribeLogDirsResponseDirTopic struct {
+	// The name of a Kafka topic.
+	Topic string
+	// The set of queried partitions for a topic that are within a log directory.
+	Partitions []DescribeLogDirsResponseDirTopicPartition
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of DescribeLogDirsResponseDirTopic to their default values.
+func (m *DescribeLogDirsResponseDirTopic) SetDefaults() {
+	*m = DescribeLogDirsResponseDirTopic{}
+}
+
+func (m *DescribeLogDirsResponseDirTopic) encode(w *protocol.MessageWriter, version int) error {
+	if version <= 1 {
+		if err := w.WriteString(m.Topic); err != nil {
+			return err
+		}
+	} else if version >= 2 {
+		if err := w.WriteCompactString(m.Topic); err != nil {
+			return err
+		}
+	}
+	if version <= 1 {
+		if err := w.WriteArrayLength(len(m.Partitions)); err != nil {
+			return err
+		}
+		for i := range m.Partitions {
+			if err := m.Partitions[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 2 {
+		if err := w.WriteCompactArrayLength(len(m.Partitions)); err != nil {
+			return err
+		}
+		for i := range m.Partitions {
+			if err := m.Partitions[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 2 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DescribeLogDirsResponseDirTopic) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version <= 1 {
+		if m.Topic, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version >= 2 {
+		if m.Topic, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version <= 1 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Partitions was serialized as null")
+		} else {
+			m.Partitions = make([]DescribeLogDirsResponseDirTopicPartition, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeLogDirsResponseDirTopicPartition
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Partitions = append(m.Partitions, e)
+			}
+		}
+	} else if version >= 2 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Partitions was serialized as null")
+		} else {
+			m.Partitions = make([]DescribeLogDirsResponseDirTopicPartition, 0)
+			for i := 0; i < n; i++ {
+				var e DescribeLogDirsResponseDirTopicPartition
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Partitions = append(m.Partitions, e)
+			}
+		}
+	}
+	if version >= 2 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// DescribeLogDirsResponseDirTopicPartition is the type of DescribeLogDirsResponseDirTopic.Partitions.
+type DescribeLogDirsResponseDirTopicPartition struct {
+	// A partition ID.
+	Partition int32
+	// The total size of the log sements of this partition, in bytes.
+	Size int64
+	// How far behind the log end offset is compared to the partition's high watermark (if this is the current log for the partition) or compared to the current replica's log end offset (if this is the future log for the patition).
+	OffsetLag int64
+	// True if this replica was created by an AlterReplicaLogDirsRequest and will replace the current log of the replica in the future.
+	IsFuture bool
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of DescribeLogDirsResponseDirTopicPartition to their default values.
+func (m *DescribeLogDirsResponseDirTopicPartition) SetDefaults() {
+	*m = DescribeLogDirsResponseDirTopicPartition{}
+}
+
+func (m *DescribeLogDirsResponseDirTopicPartition) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt32(m.Partition); err != nil {
+		return err
+	}
+	if err := w.WriteInt64(m.Size); err != nil {
+		return err
+	}
+	if err := w.WriteInt64(m.OffsetLag); err != nil {
+		return err
+	}
+	if err := w.WriteBool(m.IsFuture); err != nil {
+		return err
+	}
+	if version >= 2 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DescribeLogDirsResponseDirTopicPartition) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.Partition, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if m.Size, err = r.ReadInt64(); err != nil {
+		return err
+	}
+	if m.OffsetLag, err = r.ReadInt64(); err != nil {
+		return err
+	}
+	if m.IsFuture, err = r.ReadBool(); err != nil {
+		return err
+	}
+	if version >= 2 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+// Package messages holds the request, response and header types that protocol-gen-kafka generates
+// from the Kafka message specs, and helpers to encode and decode them with their headers.
+//
+// The generator writes one file for every spec in its input directory, and the checked-in files
+// cover every Kafka API from Produce (key 0) to ConsumerGroupHeartbeat (key 68) along with the
+// request and response headers. Each generated API registers itself with protocol.Lookup. The
+// generator itself is tested against the fixture specs and golden files in
+// internal/codegen/testdata.
+package messages
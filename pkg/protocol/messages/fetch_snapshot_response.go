@@ -0,0 +1,452 @@
+// Code generated by protocol-gen-kafka from FetchSnapshotResponse.json. DO NOT EDIT.
+
+package messages
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+)
+
+// FetchSnapshotResponse is the response for ApiKey 59.
+//
+// Valid versions: 0. Flexible versions: 0+.
+type FetchSnapshotResponse struct {
+	version       int
+	correlationId int
+
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs int32
+	// The top level response error code.
+	ErrorCode int16
+	// The topics to fetch.
+	Topics []FetchSnapshotResponseTopic
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+func init() {
+	protocol.RegisterResponse(protocol.ApiKey(59), func(version int) protocol.Message {
+		return NewFetchSnapshotResponse(version)
+	})
+}
+
+func NewFetchSnapshotResponse(version int) *FetchSnapshotResponse {
+	m := &FetchSnapshotResponse{version: version}
+	m.SetDefaults()
+	return m
+}
+
+func (m *FetchSnapshotResponse) ApiKey() protocol.ApiKey {
+	return protocol.ApiKey(59)
+}
+
+func (m *FetchSnapshotResponse) Version() int {
+	return m.version
+}
+
+func (m *FetchSnapshotResponse) SetVersion(version int) {
+	m.version = version
+}
+
+func (m *FetchSnapshotResponse) CorrelationId() int {
+	return m.correlationId
+}
+
+func (m *FetchSnapshotResponse) SetCorrelationId(correlationId int) {
+	m.correlationId = correlationId
+}
+
+// Marshal encodes the message at its version. It returns nil if the message cannot be
+// encoded; use Encode to get the error.
+func (m *FetchSnapshotResponse) Marshal() []byte {
+	var buf bytes.Buffer
+	if err := m.Encode(protocol.NewMessageWriter(&buf)); err != nil {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+// Unmarshal decodes the message at its version.
+func (m *FetchSnapshotResponse) Unmarshal(b []byte) error {
+	return m.Decode(protocol.NewMessageReader(bytes.NewReader(b)))
+}
+
+func (m *FetchSnapshotResponse) Encode(w *protocol.MessageWriter) error {
+	if m.version < 0 || m.version > 0 {
+		return fmt.Errorf("%w: FetchSnapshotResponse v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.encode(w, m.version)
+}
+
+func (m *FetchSnapshotResponse) Decode(r *protocol.MessageReader) error {
+	if m.version < 0 || m.version > 0 {
+		return fmt.Errorf("%w: FetchSnapshotResponse v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.decode(r, m.version)
+}
+
+// SetDefaults resets all fields of FetchSnapshotResponse to their default values.
+func (m *FetchSnapshotResponse) SetDefaults() {
+	*m = FetchSnapshotResponse{version: m.version, correlationId: m.correlationId}
+}
+
+func (m *FetchSnapshotResponse) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt32(m.ThrottleTimeMs); err != nil {
+		return err
+	}
+	if err := w.WriteInt16(m.ErrorCode); err != nil {
+		return err
+	}
+	if err := w.WriteCompactArrayLength(len(m.Topics)); err != nil {
+		return err
+	}
+	for i := range m.Topics {
+		if err := m.Topics[i].encode(w, version); err != nil {
+			return err
+		}
+	}
+	{
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *FetchSnapshotResponse) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.ThrottleTimeMs, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if m.ErrorCode, err = r.ReadInt16(); err != nil {
+		return err
+	}
+	if n, err := r.ReadCompactArrayLength(); err != nil {
+		return err
+	} else if n < 0 {
+		return fmt.Errorf("non-nullable field Topics was serialized as null")
+	} else {
+		m.Topics = make([]FetchSnapshotResponseTopic, 0)
+		for i := 0; i < n; i++ {
+			var e FetchSnapshotResponseTopic
+			if err := e.decode(r, version); err != nil {
+				return err
+			}
+			m.Topics = append(m.Topics, e)
+		}
+	}
+	{
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// FetchSnapshotResponseTopic is the type of FetchSnapshotResponse.Topics.
+type FetchSnapshotResponseTopic struct {
+	// The name of the topic to fetch.
+	Topic string
+	// The partitions to fetch.
+	Partitions []FetchSnapshotResponseTopicPartition
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of FetchSnapshotResponseTopic to their default values.
+func (m *FetchSnapshotResponseTopic) SetDefaults() {
+	*m = FetchSnapshotResponseTopic{}
+}
+
+func (m *FetchSnapshotResponseTopic) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteCompactString(m.Topic); err != nil {
+		return err
+	}
+	if err := w.WriteCompactArrayLength(len(m.Partitions)); err != nil {
+		return err
+	}
+	for i := range m.Partitions {
+		if err := m.Partitions[i].encode(w, version); err != nil {
+			return err
+		}
+	}
+	{
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *FetchSnapshotResponseTopic) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.Topic, err = r.ReadCompactString(); err != nil {
+		return err
+	}
+	if n, err := r.ReadCompactArrayLength(); err != nil {
+		return err
+	} else if n < 0 {
+		return fmt.Errorf("non-nullable field Partitions was serialized as null")
+	} else {
+		m.Partitions = make([]FetchSnapshotResponseTopicPartition, 0)
+		for i := 0; i < n; i++ {
+			var e FetchSnapshotResponseTopicPartition
+			if err := e.decode(r, version); err != nil {
+				return err
+			}
+			m.Partitions = append(m.Partitions, e)
+		}
+	}
+	{
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// FetchSnapshotResponseTopicPartition is the type of FetchSnapshotResponseTopic.Partitions.
+type FetchSnapshotResponseTopicPartition struct {
+	// The partition.
+	Partition int32
+	// An error code, or 0 if there was no fetch error.
+	ErrorCode int16
+	// The snapshot end offset and epoch to fetch.
+	SnapshotId FetchSnapshotResponseTopicPartitionSnapshotId
+	// The ID of the current leader (or -1 if unknown) and the latest known leader epoch.
+	CurrentLeader FetchSnapshotResponseTopicPartitionCurrentLeader
+	// The total size of the snapshot.
+	Size int64
+	// The starting byte position within the snapshot included in the Bytes field.
+	Position int64
+	// Snapshot data.
+	Bytes []byte
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of FetchSnapshotResponseTopicPartition to their default values.
+func (m *FetchSnapshotResponseTopicPartition) SetDefaults() {
+	*m = FetchSnapshotResponseTopicPartition{}
+	m.SnapshotId.SetDefaults()
+	m.CurrentLeader.SetDefaults()
+}
+
+func (m *FetchSnapshotResponseTopicPartition) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt32(m.Partition); err != nil {
+		return err
+	}
+	if err := w.WriteInt16(m.ErrorCode); err != nil {
+		return err
+	}
+	if err := m.SnapshotId.encode(w, version); err != nil {
+		return err
+	}
+	if err := w.WriteInt64(m.Size); err != nil {
+		return err
+	}
+	if err := w.WriteInt64(m.Position); err != nil {
+		return err
+	}
+	if err := w.WriteCompactBytes(m.Bytes); err != nil {
+		return err
+	}
+	{
+		tagged := make([]protocol.TaggedField, 0, 1+len(m.UnknownTaggedFields))
+		if !m.CurrentLeader.isDefault() {
+			f, err := protocol.NewTaggedField(0, func(w *protocol.MessageWriter) error {
+				if err := m.CurrentLeader.encode(w, version); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			tagged = append(tagged, f)
+		}
+		tagged = append(tagged, m.UnknownTaggedFields...)
+		if err := w.WriteTaggedFields(tagged); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *FetchSnapshotResponseTopicPartition) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.Partition, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if m.ErrorCode, err = r.ReadInt16(); err != nil {
+		return err
+	}
+	if err := m.SnapshotId.decode(r, version); err != nil {
+		return err
+	}
+	if m.Size, err = r.ReadInt64(); err != nil {
+		return err
+	}
+	if m.Position, err = r.ReadInt64(); err != nil {
+		return err
+	}
+	if m.Bytes, err = r.ReadCompactBytes(); err != nil {
+		return err
+	}
+	{
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			case f.Tag == 0:
+				if err := func(r *protocol.MessageReader) (err error) {
+					if err := m.CurrentLeader.decode(r, version); err != nil {
+						return err
+					}
+					return nil
+				}(f.Reader()); err != nil {
+					return err
+				}
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// FetchSnapshotResponseTopicPartitionSnapshotId is the type of FetchSnapshotResponseTopicPartition.SnapshotId.
+type FetchSnapshotResponseTopicPartitionSnapshotId struct {
+	EndOffset int64
+	Epoch     int32
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of FetchSnapshotResponseTopicPartitionSnapshotId to their default values.
+func (m *FetchSnapshotResponseTopicPartitionSnapshotId) SetDefaults() {
+	*m = FetchSnapshotResponseTopicPartitionSnapshotId{}
+}
+
+func (m *FetchSnapshotResponseTopicPartitionSnapshotId) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt64(m.EndOffset); err != nil {
+		return err
+	}
+	if err := w.WriteInt32(m.Epoch); err != nil {
+		return err
+	}
+	{
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *FetchSnapshotResponseTopicPartitionSnapshotId) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.EndOffset, err = r.ReadInt64(); err != nil {
+		return err
+	}
+	if m.Epoch, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	{
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// FetchSnapshotResponseTopicPartitionCurrentLeader is the type of FetchSnapshotResponseTopicPartition.CurrentLeader.
+type FetchSnapshotResponseTopicPartitionCurrentLeader struct {
+	LeaderId    int32
+	LeaderEpoch int32
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of FetchSnapshotResponseTopicPartitionCurrentLeader to their default values.
+func (m *FetchSnapshotResponseTopicPartitionCurrentLeader) SetDefaults() {
+	*m = FetchSnapshotResponseTopicPartitionCurrentLeader{}
+}
+
+func (m *FetchSnapshotResponseTopicPartitionCurrentLeader) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt32(m.LeaderId); err != nil {
+		return err
+	}
+	if err := w.WriteInt32(m.LeaderEpoch); err != nil {
+		return err
+	}
+	{
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *FetchSnapshotResponseTopicPartitionCurrentLeader) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.LeaderId, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if m.LeaderEpoch, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	{
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *FetchSnapshotResponseTopicPartitionCurrentLeader) isDefault() bool {
+	return m.LeaderId == 0 &&
+		m.LeaderEpoch == 0 &&
+		len(m.UnknownTaggedFields) == 0
+}
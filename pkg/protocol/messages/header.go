@@ -0,0 +1,88 @@
+package messages
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+)
+
+// RequestHeaderFor returns the request header for m, at the header version used by the API key
+// and version of m.
+func RequestHeaderFor(m protocol.Message, clientId *string) *RequestHeader {
+	h := NewRequestHeader(protocol.RequestHeaderVersion(m.ApiKey(), m.Version()))
+	h.RequestApiKey = int16(m.ApiKey())
+	h.RequestApiVersion = int16(m.Version())
+	h.CorrelationId = int32(m.CorrelationId())
+	h.ClientId = clientId
+	return h
+}
+
+// ResponseHeaderFor returns the response header for m, at the header version used by the API key
+// and version of m.
+func ResponseHeaderFor(m protocol.Message) *ResponseHeader {
+	h := NewResponseHeader(protocol.ResponseHeaderVersion(m.ApiKey(), m.Version()))
+	h.CorrelationId = int32(m.CorrelationId())
+	return h
+}
+
+// EncodeRequest writes the request header for m followed by m.
+func EncodeRequest(w *protocol.MessageWriter, m protocol.Message, clientId *string) error {
+	if err := RequestHeaderFor(m, clientId).Encode(w); err != nil {
+		return err
+	}
+	return m.Encode(w)
+}
+
+// EncodeResponse writes the response header for m followed by m.
+func EncodeResponse(w *protocol.MessageWriter, m protocol.Message) error {
+	if err := ResponseHeaderFor(m).Encode(w); err != nil {
+		return err
+	}
+	return m.Encode(w)
+}
+
+// DecodeResponse reads a response header followed by m, which must already have the version of
+// the request it answers. The correlation id of m is set from the header.
+func DecodeResponse(r *protocol.MessageReader, m protocol.Message) error {
+	h := NewResponseHeader(protocol.ResponseHeaderVersion(m.ApiKey(), m.Version()))
+	if err := h.Decode(r); err != nil {
+		return err
+	}
+	m.SetCorrelationId(int(h.CorrelationId))
+	return m.Decode(r)
+}
+
+// DecodeRequest decodes a request frame, choosing the request type from the API key and version at
+// the start of the header. If the API key is unknown or the version is not supported, the header
+// is still returned where possible so that the caller can answer with an error.
+func DecodeRequest(frame []byte) (*RequestHeader, protocol.Message, error) {
+	if len(frame) < 4 {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	key := protocol.ApiKey(int16(binary.BigEndian.Uint16(frame)))
+	version := int(int16(binary.BigEndian.Uint16(frame[2:])))
+
+	r := protocol.NewMessageReader(bytes.NewReader(frame))
+	h := NewRequestHeader(protocol.RequestHeaderVersion(key, version))
+	if err := h.Decode(r); err != nil {
+		return nil, nil, err
+	}
+
+	api, ok := protocol.Lookup(key)
+	if !ok {
+		return h, nil, fmt.Errorf("%w: %v", protocol.ErrUnknownApiKey, key)
+	}
+	if !api.Supports(version) {
+		return h, nil, fmt.Errorf("%w: %v v%d", protocol.ErrVersionNotImplemented, key, version)
+	}
+
+	m := api.NewRequest(version)
+	m.SetCorrelationId(int(h.CorrelationId))
+	if err := m.Decode(r); err != nil {
+		return h, nil, err
+	}
+	return h, m, nil
+}
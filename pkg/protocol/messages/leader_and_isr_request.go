@@ -0,0 +1,796 @@
+// Code generated by protocol-gen-kafka from LeaderAndIsrRequest.json. DO NOT EDIT.
+
+package messages
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/google/uuid"
+)
+
+// LeaderAndIsrRequest is the request for ApiKey 4.
+//
+// Valid versions: 0-7. Flexible versions: 4+.
+type LeaderAndIsrRequest struct {
+	version       int
+	correlationId int
+
+	ControllerId int32
+	// If KRaft controller id is used during migration.
+	IsKRaftController bool
+	ControllerEpoch   int32
+	BrokerEpoch       int64
+	Type              int8
+	PartitionStates   []LeaderAndIsrRequestTopicPartition
+	TopicStates       []LeaderAndIsrRequestTopicState
+	LiveLeaders       []LeaderAndIsrRequestLiveLeader
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+func init() {
+	protocol.RegisterRequest(protocol.ApiKey(4), 0, 7, 4, func(version int) protocol.Message {
+		return NewLeaderAndIsrRequest(version)
+	})
+}
+
+func NewLeaderAndIsrRequest(version int) *LeaderAndIsrRequest {
+	m := &LeaderAndIsrRequest{version: version}
+	m.SetDefaults()
+	return m
+}
+
+func (m *LeaderAndIsrRequest) ApiKey() protocol.ApiKey {
+	return protocol.ApiKey(4)
+}
+
+func (m *LeaderAndIsrRequest) Version() int {
+	return m.version
+}
+
+func (m *LeaderAndIsrRequest) SetVersion(version int) {
+	m.version = version
+}
+
+func (m *LeaderAndIsrRequest) CorrelationId() int {
+	return m.correlationId
+}
+
+func (m *LeaderAndIsrRequest) SetCorrelationId(correlationId int) {
+	m.correlationId = correlationId
+}
+
+// Marshal encodes the message at its version. It returns nil if the message cannot be
+// encoded; use Encode to get the error.
+func (m *LeaderAndIsrRequest) Marshal() []byte {
+	var buf bytes.Buffer
+	if err := m.Encode(protocol.NewMessageWriter(&buf)); err != nil {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+// Unmarshal decodes the message at its version.
+func (m *LeaderAndIsrRequest) Unmarshal(b []byte) error {
+	return m.Decode(protocol.NewMessageReader(bytes.NewReader(b)))
+}
+
+func (m *LeaderAndIsrRequest) Encode(w *protocol.MessageWriter) error {
+	if m.version < 0 || m.version > 7 {
+		return fmt.Errorf("%w: LeaderAndIsrRequest v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.encode(w, m.version)
+}
+
+func (m *LeaderAndIsrRequest) Decode(r *protocol.MessageReader) error {
+	if m.version < 0 || m.version > 7 {
+		return fmt.Errorf("%w: LeaderAndIsrRequest v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.decode(r, m.version)
+}
+
+// SetDefaults resets all fields of LeaderAndIsrRequest to their default values.
+func (m *LeaderAndIsrRequest) SetDefaults() {
+	*m = LeaderAndIsrRequest{version: m.version, correlationId: m.correlationId, BrokerEpoch: -1}
+}
+
+func (m *LeaderAndIsrRequest) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt32(m.ControllerId); err != nil {
+		return err
+	}
+	if version == 7 {
+		if err := w.WriteBool(m.IsKRaftController); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteInt32(m.ControllerEpoch); err != nil {
+		return err
+	}
+	if version >= 2 {
+		if err := w.WriteInt64(m.BrokerEpoch); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		if err := w.WriteInt8(m.Type); err != nil {
+			return err
+		}
+	}
+	if version <= 1 {
+		if err := w.WriteArrayLength(len(m.PartitionStates)); err != nil {
+			return err
+		}
+		for i := range m.PartitionStates {
+			if err := m.PartitionStates[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 2 && version <= 3 {
+		if err := w.WriteArrayLength(len(m.TopicStates)); err != nil {
+			return err
+		}
+		for i := range m.TopicStates {
+			if err := m.TopicStates[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 4 {
+		if err := w.WriteCompactArrayLength(len(m.TopicStates)); err != nil {
+			return err
+		}
+		for i := range m.TopicStates {
+			if err := m.TopicStates[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version <= 3 {
+		if err := w.WriteArrayLength(len(m.LiveLeaders)); err != nil {
+			return err
+		}
+		for i := range m.LiveLeaders {
+			if err := m.LiveLeaders[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 4 {
+		if err := w.WriteCompactArrayLength(len(m.LiveLeaders)); err != nil {
+			return err
+		}
+		for i := range m.LiveLeaders {
+			if err := m.LiveLeaders[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 4 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *LeaderAndIsrRequest) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.ControllerId, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version == 7 {
+		if m.IsKRaftController, err = r.ReadBool(); err != nil {
+			return err
+		}
+	}
+	if m.ControllerEpoch, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version >= 2 {
+		if m.BrokerEpoch, err = r.ReadInt64(); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		if m.Type, err = r.ReadInt8(); err != nil {
+			return err
+		}
+	}
+	if version <= 1 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field PartitionStates was serialized as null")
+		} else {
+			m.PartitionStates = make([]LeaderAndIsrRequestTopicPartition, 0)
+			for i := 0; i < n; i++ {
+				var e LeaderAndIsrRequestTopicPartition
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.PartitionStates = append(m.PartitionStates, e)
+			}
+		}
+	}
+	if version >= 2 && version <= 3 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field TopicStates was serialized as null")
+		} else {
+			m.TopicStates = make([]LeaderAndIsrRequestTopicState, 0)
+			for i := 0; i < n; i++ {
+				var e LeaderAndIsrRequestTopicState
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.TopicStates = append(m.TopicStates, e)
+			}
+		}
+	} else if version >= 4 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field TopicStates was serialized as null")
+		} else {
+			m.TopicStates = make([]LeaderAndIsrRequestTopicState, 0)
+			for i := 0; i < n; i++ {
+				var e LeaderAndIsrRequestTopicState
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.TopicStates = append(m.TopicStates, e)
+			}
+		}
+	}
+	if version <= 3 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field LiveLeaders was serialized as null")
+		} else {
+			m.LiveLeaders = make([]LeaderAndIsrRequestLiveLeader, 0)
+			for i := 0; i < n; i++ {
+				var e LeaderAndIsrRequestLiveLeader
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.LiveLeaders = append(m.LiveLeaders, e)
+			}
+		}
+	} else if version >= 4 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field LiveLeaders was serialized as null")
+		} else {
+			m.LiveLeaders = make([]LeaderAndIsrRequestLiveLeader, 0)
+			for i := 0; i < n; i++ {
+				var e LeaderAndIsrRequestLiveLeader
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.LiveLeaders = append(m.LiveLeaders, e)
+			}
+		}
+	}
+	if version >= 4 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// LeaderAndIsrRequestTopicState is the type of LeaderAndIsrRequest.TopicStates.
+type LeaderAndIsrRequestTopicState struct {
+	Topic           string
+	TopicId         uuid.UUID
+	PartitionStates []LeaderAndIsrRequestTopicPartition
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of LeaderAndIsrRequestTopicState to their default values.
+func (m *LeaderAndIsrRequestTopicState) SetDefaults() {
+	*m = LeaderAndIsrRequestTopicState{}
+}
+
+func (m *LeaderAndIsrRequestTopicState) encode(w *protocol.MessageWriter, version int) error {
+	if version <= 3 {
+		if err := w.WriteString(m.Topic); err != nil {
+			return err
+		}
+	} else if version >= 4 {
+		if err := w.WriteCompactString(m.Topic); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		if err := w.WriteUuid(m.TopicId); err != nil {
+			return err
+		}
+	}
+	if version <= 3 {
+		if err := w.WriteArrayLength(len(m.PartitionStates)); err != nil {
+			return err
+		}
+		for i := range m.PartitionStates {
+			if err := m.PartitionStates[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 4 {
+		if err := w.WriteCompactArrayLength(len(m.PartitionStates)); err != nil {
+			return err
+		}
+		for i := range m.PartitionStates {
+			if err := m.PartitionStates[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 4 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *LeaderAndIsrRequestTopicState) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version <= 3 {
+		if m.Topic, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version >= 4 {
+		if m.Topic, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version >= 5 {
+		if m.TopicId, err = r.ReadUuid(); err != nil {
+			return err
+		}
+	}
+	if version <= 3 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field PartitionStates was serialized as null")
+		} else {
+			m.PartitionStates = make([]LeaderAndIsrRequestTopicPartition, 0)
+			for i := 0; i < n; i++ {
+				var e LeaderAndIsrRequestTopicPartition
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.PartitionStates = append(m.PartitionStates, e)
+			}
+		}
+	} else if version >= 4 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field PartitionStates was serialized as null")
+		} else {
+			m.PartitionStates = make([]LeaderAndIsrRequestTopicPartition, 0)
+			for i := 0; i < n; i++ {
+				var e LeaderAndIsrRequestTopicPartition
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.PartitionStates = append(m.PartitionStates, e)
+			}
+		}
+	}
+	if version >= 4 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// LeaderAndIsrRequestLiveLeader is the type of LeaderAndIsrRequest.LiveLeaders.
+type LeaderAndIsrRequestLiveLeader struct {
+	BrokerId int32
+	Host     string
+	Port     int32
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of LeaderAndIsrRequestLiveLeader to their default values.
+func (m *LeaderAndIsrRequestLiveLeader) SetDefaults() {
+	*m = LeaderAndIsrRequestLiveLeader{}
+}
+
+func (m *LeaderAndIsrRequestLiveLeader) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt32(m.BrokerId); err != nil {
+		return err
+	}
+	if version <= 3 {
+		if err := w.WriteString(m.Host); err != nil {
+			return err
+		}
+	} else if version >= 4 {
+		if err := w.WriteCompactString(m.Host); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteInt32(m.Port); err != nil {
+		return err
+	}
+	if version >= 4 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *LeaderAndIsrRequestLiveLeader) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.BrokerId, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version <= 3 {
+		if m.Host, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version >= 4 {
+		if m.Host, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if m.Port, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version >= 4 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// LeaderAndIsrRequestTopicPartition is a common struct of LeaderAndIsrRequest.
+type LeaderAndIsrRequestTopicPartition struct {
+	Topic               string
+	Partition           int32
+	ControllerEpoch     int32
+	Leader              int32
+	LeaderEpoch         int32
+	Isr                 []int32
+	ZkVersion           int32
+	Replicas            []int32
+	AddingReplicas      []int32
+	RemovingReplicas    []int32
+	IsNew               bool
+	LeaderRecoveryState int8
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of LeaderAndIsrRequestTopicPartition to their default values.
+func (m *LeaderAndIsrRequestTopicPartition) SetDefaults() {
+	*m = LeaderAndIsrRequestTopicPartition{}
+}
+
+func (m *LeaderAndIsrRequestTopicPartition) encode(w *protocol.MessageWriter, version int) error {
+	if version <= 1 {
+		if err := w.WriteString(m.Topic); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteInt32(m.Partition); err != nil {
+		return err
+	}
+	if err := w.WriteInt32(m.ControllerEpoch); err != nil {
+		return err
+	}
+	if err := w.WriteInt32(m.Leader); err != nil {
+		return err
+	}
+	if err := w.WriteInt32(m.LeaderEpoch); err != nil {
+		return err
+	}
+	if version <= 3 {
+		if err := w.WriteArrayLength(len(m.Isr)); err != nil {
+			return err
+		}
+		for i := range m.Isr {
+			if err := w.WriteInt32(m.Isr[i]); err != nil {
+				return err
+			}
+		}
+	} else if version >= 4 {
+		if err := w.WriteCompactArrayLength(len(m.Isr)); err != nil {
+			return err
+		}
+		for i := range m.Isr {
+			if err := w.WriteInt32(m.Isr[i]); err != nil {
+				return err
+			}
+		}
+	}
+	if err := w.WriteInt32(m.ZkVersion); err != nil {
+		return err
+	}
+	if version <= 3 {
+		if err := w.WriteArrayLength(len(m.Replicas)); err != nil {
+			return err
+		}
+		for i := range m.Replicas {
+			if err := w.WriteInt32(m.Replicas[i]); err != nil {
+				return err
+			}
+		}
+	} else if version >= 4 {
+		if err := w.WriteCompactArrayLength(len(m.Replicas)); err != nil {
+			return err
+		}
+		for i := range m.Replicas {
+			if err := w.WriteInt32(m.Replicas[i]); err != nil {
+				return err
+			}
+		}
+	}
+	if version == 3 {
+		if err := w.WriteArrayLength(len(m.AddingReplicas)); err != nil {
+			return err
+		}
+		for i := range m.AddingReplicas {
+			if err := w.WriteInt32(m.AddingReplicas[i]); err != nil {
+				return err
+			}
+		}
+	} else if version >= 4 {
+		if err := w.WriteCompactArrayLength(len(m.AddingReplicas)); err != nil {
+			return err
+		}
+		for i := range m.AddingReplicas {
+			if err := w.WriteInt32(m.AddingReplicas[i]); err != nil {
+				return err
+			}
+		}
+	}
+	if version == 3 {
+		if err := w.WriteArrayLength(len(m.RemovingReplicas)); err != nil {
+			return err
+		}
+		for i := range m.RemovingReplicas {
+			if err := w.WriteInt32(m.RemovingReplicas[i]); err != nil {
+				return err
+			}
+		}
+	} else if version >= 4 {
+		if err := w.WriteCompactArrayLength(len(m.RemovingReplicas)); err != nil {
+			return err
+		}
+		for i := range m.RemovingReplicas {
+			if err := w.WriteInt32(m.RemovingReplicas[i]); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 1 {
+		if err := w.WriteBool(m.IsNew); err != nil {
+			return err
+		}
+	}
+	if version >= 6 {
+		if err := w.WriteInt8(m.LeaderRecoveryState); err != nil {
+			return err
+		}
+	}
+	if version >= 4 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *LeaderAndIsrRequestTopicPartition) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version <= 1 {
+		if m.Topic, err = r.ReadString(); err != nil {
+			return err
+		}
+	}
+	if m.Partition, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if m.ControllerEpoch, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if m.Leader, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if m.LeaderEpoch, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version <= 3 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Isr was serialized as null")
+		} else {
+			m.Isr = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.Isr = append(m.Isr, e)
+			}
+		}
+	} else if version >= 4 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Isr was serialized as null")
+		} else {
+			m.Isr = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.Isr = append(m.Isr, e)
+			}
+		}
+	}
+	if m.ZkVersion, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version <= 3 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Replicas was serialized as null")
+		} else {
+			m.Replicas = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.Replicas = append(m.Replicas, e)
+			}
+		}
+	} else if version >= 4 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Replicas was serialized as null")
+		} else {
+			m.Replicas = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.Replicas = append(m.Replicas, e)
+			}
+		}
+	}
+	if version == 3 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field AddingReplicas was serialized as null")
+		} else {
+			m.AddingReplicas = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.AddingReplicas = append(m.AddingReplicas, e)
+			}
+		}
+	} else if version >= 4 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field AddingReplicas was serialized as null")
+		} else {
+			m.AddingReplicas = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.AddingReplicas = append(m.AddingReplicas, e)
+			}
+		}
+	}
+	if version == 3 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field RemovingReplicas was serialized as null")
+		} else {
+			m.RemovingReplicas = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.RemovingReplicas = append(m.RemovingReplicas, e)
+			}
+		}
+	} else if version >= 4 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field RemovingReplicas was serialized as null")
+		} else {
+			m.RemovingReplicas = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.RemovingReplicas = append(m.RemovingReplicas, e)
+			}
+		}
+	}
+	if version >= 1 {
+		if m.IsNew, err = r.ReadBool(); err != nil {
+			return err
+		}
+	}
+	if version >= 6 {
+		if m.LeaderRecoveryState, err = r.ReadInt8(); err != nil {
+			return err
+		}
+	}
+	if version >= 4 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package messages
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+)
+
+// fill sets every exported field of v to a value other than its zero value, so that a round trip
+// covers every field that a version encodes.
+func fill(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(v.Elem())
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(uuid.UUID{}) {
+			v.Set(reflect.ValueOf(uuid.MustParse("0e4a1bfa-3c2b-4d5e-8f90-123456789abc")))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fill(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		if v.Type() == reflect.TypeOf([]protocol.TaggedField(nil)) {
+			// a tag above the known tags of every message, which must survive the round trip
+			v.Set(reflect.ValueOf([]protocol.TaggedField{{Tag: 90, Data: []byte{7, 8}}}))
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			fill(v.Index(i))
+		}
+	case reflect.String:
+		v.SetString("kafka")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(3)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		v.SetUint(3)
+	case reflect.Float64:
+		v.SetFloat(0.5)
+	}
+}
+
+func encode(t *testing.T, m protocol.Message) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := m.Encode(protocol.NewMessageWriter(&buf)); err != nil {
+		t.Fatalf("%v v%d: encode: %v", m.ApiKey(), m.Version(), err)
+	}
+	return buf.Bytes()
+}
+
+// TestRoundTrip encodes every request and response at every version with all of its fields set,
+// decodes it and checks that encoding the decoded message gives the same bytes.
+func TestRoundTrip(t *testing.T) {
+	apis := protocol.Apis()
+	if len(apis) == 0 {
+		t.Fatal("no registered APIs")
+	}
+
+	for _, api := range apis {
+		for version := api.MinVersion; version <= api.MaxVersion; version++ {
+			for _, newMessage := range []func(int) protocol.Message{api.NewRequest, api.NewResponse} {
+				m := newMessage(version)
+				fill(reflect.ValueOf(m).Elem())
+				data := encode(t, m)
+
+				decoded := newMessage(version)
+				if err := decoded.Unmarshal(data); err != nil {
+					t.Fatalf("%v v%d: decode: %v", api.Key, version, err)
+				}
+				if again := encode(t, decoded); !bytes.Equal(again, data) {
+					t.Errorf("%T v%d: round trip changed the encoding\n got %x\nwant %x", m, version, again, data)
+				}
+			}
+		}
+	}
+}
+
+func TestAllApis(t *testing.T) {
+	for key := protocol.Produce; key <= protocol.ConsumerGroupHeartbeat; key++ {
+		api, ok := protocol.Lookup(key)
+		if !ok {
+			t.Errorf("no codec for ApiKey %d", key)
+			continue
+		}
+		if req := api.NewRequest(api.MaxVersion); req.ApiKey() != key {
+			t.Errorf("request for %v has ApiKey %v", key, req.ApiKey())
+		}
+		if res := api.NewResponse(api.MaxVersion); res.ApiKey() != key {
+			t.Errorf("response for %v has ApiKey %v", key, res.ApiKey())
+		}
+	}
+}
+
+func TestRoundTripFields(t *testing.T) {
+	name := "orders"
+	m := NewMetadataRequest(4)
+	m.Topics = []MetadataRequestTopic{{Name: &name}}
+	m.AllowAutoTopicCreation = true
+
+	decoded := NewMetadataRequest(4)
+	if err := decoded.Unmarshal(m.Marshal()); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Topics) != 1 || *decoded.Topics[0].Name != name || !decoded.AllowAutoTopicCreation {
+		t.Errorf("decoded %+v", decoded)
+	}
+
+	// fields that a version does not have are left at their default
+	res := NewSaslAuthenticateResponse(1)
+	res.SessionLifetimeMs = 1000
+	v0 := NewSaslAuthenticateResponse(0)
+	res.SetVersion(0)
+	if err := v0.Unmarshal(res.Marshal()); err != nil {
+		t.Fatal(err)
+	}
+	if v0.SessionLifetimeMs != 0 {
+		t.Errorf("SessionLifetimeMs = %d in v0", v0.SessionLifetimeMs)
+	}
+}
+
+func TestEncoding(t *testing.T) {
+	// ApiVersions v0 has no fields, and FindCoordinator v0 is a single string
+	coordinator := NewFindCoordinatorRequest(0)
+	coordinator.Key = "g"
+	tests := []struct {
+		m    protocol.Message
+		want []byte
+	}{
+		{NewApiVersionsRequest(0), []byte{}},
+		{coordinator, []byte{0, 1, 'g'}},
+	}
+	for _, tt := range tests {
+		if got := encode(t, tt.m); !bytes.Equal(got, tt.want) {
+			t.Errorf("%T v%d = %x, want %x", tt.m, tt.m.Version(), got, tt.want)
+		}
+	}
+}
+
+func TestUnsupportedVersion(t *testing.T) {
+	api, _ := protocol.Lookup(protocol.Metadata)
+	m := NewMetadataRequest(api.MaxVersion + 1)
+	var buf bytes.Buffer
+	if err := m.Encode(protocol.NewMessageWriter(&buf)); !errors.Is(err, protocol.ErrVersionNotImplemented) {
+		t.Errorf("encoding an unsupported version: %v", err)
+	}
+	if err := m.Unmarshal(nil); !errors.Is(err, protocol.ErrVersionNotImplemented) {
+		t.Errorf("decoding an unsupported version: %v", err)
+	}
+	// the local error is not the error code of a broker
+	if err := m.Unmarshal(nil); errors.Is(err, protocol.ErrUnsupportedVersion) {
+		t.Errorf("%v matches ErrUnsupportedVersion", err)
+	}
+}
+
+func TestFlexibleEncoding(t *testing.T) {
+	req := NewApiVersionsRequest(3)
+	req.ClientSoftwareName = "a"
+	req.ClientSoftwareVersion = "1"
+
+	res := NewApiVersionsResponse(3)
+	tagged := NewApiVersionsResponse(3)
+	tagged.ZkMigrationReady = true
+
+	tests := []struct {
+		name string
+		m    protocol.Message
+		want []byte
+	}{
+		// compact strings are prefixed with their length plus one, and the struct ends with an
+		// empty tagged field section
+		{"compact strings", req, []byte{2, 'a', 2, '1', 0}},
+		// tagged fields at their default are omitted
+		{"default tagged fields", res, []byte{0, 0, 1, 0, 0, 0, 0, 0}},
+		// ZkMigrationReady is tag 3, holding a single bool
+		{"tagged field", tagged, []byte{0, 0, 1, 0, 0, 0, 0, 1, 3, 1, 1}},
+	}
+	for _, tt := range tests {
+		if got := encode(t, tt.m); !bytes.Equal(got, tt.want) {
+			t.Errorf("%s: %x, want %x", tt.name, got, tt.want)
+		}
+	}
+
+	decoded := NewApiVersionsResponse(3)
+	if err := decoded.Unmarshal(tests[2].want); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.ZkMigrationReady || decoded.FinalizedFeaturesEpoch != -1 || len(decoded.UnknownTaggedFields) != 0 {
+		t.Errorf("decoded %+v", decoded)
+	}
+}
+
+func TestUnknownTaggedFields(t *testing.T) {
+	unknown := []protocol.TaggedField{{Tag: 7, Data: []byte{1, 2, 3}}}
+	m := NewApiVersionsResponse(3)
+	m.ZkMigrationReady = true
+	m.UnknownTaggedFields = unknown
+
+	decoded := NewApiVersionsResponse(3)
+	if err := decoded.Unmarshal(m.Marshal()); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.ZkMigrationReady {
+		t.Error("known tagged field was not decoded")
+	}
+	if !reflect.DeepEqual(decoded.UnknownTaggedFields, unknown) {
+		t.Errorf("UnknownTaggedFields = %v, want %v", decoded.UnknownTaggedFields, unknown)
+	}
+
+	// versions before the flexible versions have no tagged fields
+	m.SetVersion(2)
+	v2 := NewApiVersionsResponse(2)
+	if err := v2.Unmarshal(m.Marshal()); err != nil {
+		t.Fatal(err)
+	}
+	if v2.ZkMigrationReady || len(v2.UnknownTaggedFields) != 0 {
+		t.Errorf("decoded tagged fields in v2: %+v", v2)
+	}
+
+	// tagged fields must be in ascending order: two empty strings, then tags 2 and 1
+	if err := NewApiVersionsRequest(3).Unmarshal([]byte{1, 1, 2, 2, 0, 1, 0}); err == nil {
+		t.Error("decoded a request with malformed tagged fields")
+	}
+}
+
+func TestNullableCompactString(t *testing.T) {
+	empty := ""
+	for _, name := range []*string{nil, &empty} {
+		m := NewMetadataRequest(12)
+		m.Topics = []MetadataRequestTopic{{Name: name}}
+
+		decoded := NewMetadataRequest(12)
+		if err := decoded.Unmarshal(m.Marshal()); err != nil {
+			t.Fatal(err)
+		}
+		if got := decoded.Topics[0].Name; (got == nil) != (name == nil) {
+			t.Errorf("Name = %v, want %v", got, name)
+		}
+	}
+
+	// a null array is distinct from an empty one
+	m := NewMetadataRequest(12)
+	m.Topics = nil
+	decoded := NewMetadataRequest(12)
+	if err := decoded.Unmarshal(m.Marshal()); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Topics != nil {
+		t.Errorf("Topics = %v, want nil", decoded.Topics)
+	}
+}
@@ -0,0 +1,869 @@
+// Code generated by protocol-gen-kafka from UpdateMetadataRequest.json. DO NOT EDIT.
+
+package messages
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/protocol"
+	"github.com/google/uuid"
+)
+
+// UpdateMetadataRequest is the request for ApiKey 6.
+//
+// Valid versions: 0-8. Flexible versions: 6+.
+type UpdateMetadataRequest struct {
+	version       int
+	correlationId int
+
+	ControllerId int32
+	// If KRaft controller id is used during migration.
+	IsKRaftController bool
+	ControllerEpoch   int32
+	BrokerEpoch       int64
+	PartitionStates   []UpdateMetadataRequestTopicPartition
+	TopicStates       []UpdateMetadataRequestTopicState
+	LiveBrokers       []UpdateMetadataRequestLiveBroker
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+func init() {
+	protocol.RegisterRequest(protocol.ApiKey(6), 0, 8, 6, func(version int) protocol.Message {
+		return NewUpdateMetadataRequest(version)
+	})
+}
+
+func NewUpdateMetadataRequest(version int) *UpdateMetadataRequest {
+	m := &UpdateMetadataRequest{version: version}
+	m.SetDefaults()
+	return m
+}
+
+func (m *UpdateMetadataRequest) ApiKey() protocol.ApiKey {
+	return protocol.ApiKey(6)
+}
+
+func (m *UpdateMetadataRequest) Version() int {
+	return m.version
+}
+
+func (m *UpdateMetadataRequest) SetVersion(version int) {
+	m.version = version
+}
+
+func (m *UpdateMetadataRequest) CorrelationId() int {
+	return m.correlationId
+}
+
+func (m *UpdateMetadataRequest) SetCorrelationId(correlationId int) {
+	m.correlationId = correlationId
+}
+
+// Marshal encodes the message at its version. It returns nil if the message cannot be
+// encoded; use Encode to get the error.
+func (m *UpdateMetadataRequest) Marshal() []byte {
+	var buf bytes.Buffer
+	if err := m.Encode(protocol.NewMessageWriter(&buf)); err != nil {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+// Unmarshal decodes the message at its version.
+func (m *UpdateMetadataRequest) Unmarshal(b []byte) error {
+	return m.Decode(protocol.NewMessageReader(bytes.NewReader(b)))
+}
+
+func (m *UpdateMetadataRequest) Encode(w *protocol.MessageWriter) error {
+	if m.version < 0 || m.version > 8 {
+		return fmt.Errorf("%w: UpdateMetadataRequest v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.encode(w, m.version)
+}
+
+func (m *UpdateMetadataRequest) Decode(r *protocol.MessageReader) error {
+	if m.version < 0 || m.version > 8 {
+		return fmt.Errorf("%w: UpdateMetadataRequest v%d", protocol.ErrVersionNotImplemented, m.version)
+	}
+	return m.decode(r, m.version)
+}
+
+// SetDefaults resets all fields of UpdateMetadataRequest to their default values.
+func (m *UpdateMetadataRequest) SetDefaults() {
+	*m = UpdateMetadataRequest{version: m.version, correlationId: m.correlationId, BrokerEpoch: -1}
+}
+
+func (m *UpdateMetadataRequest) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt32(m.ControllerId); err != nil {
+		return err
+	}
+	if version == 8 {
+		if err := w.WriteBool(m.IsKRaftController); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteInt32(m.ControllerEpoch); err != nil {
+		return err
+	}
+	if version >= 5 {
+		if err := w.WriteInt64(m.BrokerEpoch); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if err := w.WriteArrayLength(len(m.PartitionStates)); err != nil {
+			return err
+		}
+		for i := range m.PartitionStates {
+			if err := m.PartitionStates[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version == 5 {
+		if err := w.WriteArrayLength(len(m.TopicStates)); err != nil {
+			return err
+		}
+		for i := range m.TopicStates {
+			if err := m.TopicStates[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 6 {
+		if err := w.WriteCompactArrayLength(len(m.TopicStates)); err != nil {
+			return err
+		}
+		for i := range m.TopicStates {
+			if err := m.TopicStates[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version <= 5 {
+		if err := w.WriteArrayLength(len(m.LiveBrokers)); err != nil {
+			return err
+		}
+		for i := range m.LiveBrokers {
+			if err := m.LiveBrokers[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 6 {
+		if err := w.WriteCompactArrayLength(len(m.LiveBrokers)); err != nil {
+			return err
+		}
+		for i := range m.LiveBrokers {
+			if err := m.LiveBrokers[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 6 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *UpdateMetadataRequest) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.ControllerId, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version == 8 {
+		if m.IsKRaftController, err = r.ReadBool(); err != nil {
+			return err
+		}
+	}
+	if m.ControllerEpoch, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version >= 5 {
+		if m.BrokerEpoch, err = r.ReadInt64(); err != nil {
+			return err
+		}
+	}
+	if version <= 4 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field PartitionStates was serialized as null")
+		} else {
+			m.PartitionStates = make([]UpdateMetadataRequestTopicPartition, 0)
+			for i := 0; i < n; i++ {
+				var e UpdateMetadataRequestTopicPartition
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.PartitionStates = append(m.PartitionStates, e)
+			}
+		}
+	}
+	if version == 5 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field TopicStates was serialized as null")
+		} else {
+			m.TopicStates = make([]UpdateMetadataRequestTopicState, 0)
+			for i := 0; i < n; i++ {
+				var e UpdateMetadataRequestTopicState
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.TopicStates = append(m.TopicStates, e)
+			}
+		}
+	} else if version >= 6 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field TopicStates was serialized as null")
+		} else {
+			m.TopicStates = make([]UpdateMetadataRequestTopicState, 0)
+			for i := 0; i < n; i++ {
+				var e UpdateMetadataRequestTopicState
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.TopicStates = append(m.TopicStates, e)
+			}
+		}
+	}
+	if version <= 5 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field LiveBrokers was serialized as null")
+		} else {
+			m.LiveBrokers = make([]UpdateMetadataRequestLiveBroker, 0)
+			for i := 0; i < n; i++ {
+				var e UpdateMetadataRequestLiveBroker
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.LiveBrokers = append(m.LiveBrokers, e)
+			}
+		}
+	} else if version >= 6 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field LiveBrokers was serialized as null")
+		} else {
+			m.LiveBrokers = make([]UpdateMetadataRequestLiveBroker, 0)
+			for i := 0; i < n; i++ {
+				var e UpdateMetadataRequestLiveBroker
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.LiveBrokers = append(m.LiveBrokers, e)
+			}
+		}
+	}
+	if version >= 6 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateMetadataRequestTopicState is the type of UpdateMetadataRequest.TopicStates.
+type UpdateMetadataRequestTopicState struct {
+	Topic           string
+	TopicId         uuid.UUID
+	PartitionStates []UpdateMetadataRequestTopicPartition
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of UpdateMetadataRequestTopicState to their default values.
+func (m *UpdateMetadataRequestTopicState) SetDefaults() {
+	*m = UpdateMetadataRequestTopicState{}
+}
+
+func (m *UpdateMetadataRequestTopicState) encode(w *protocol.MessageWriter, version int) error {
+	if version <= 5 {
+		if err := w.WriteString(m.Topic); err != nil {
+			return err
+		}
+	} else if version >= 6 {
+		if err := w.WriteCompactString(m.Topic); err != nil {
+			return err
+		}
+	}
+	if version >= 7 {
+		if err := w.WriteUuid(m.TopicId); err != nil {
+			return err
+		}
+	}
+	if version <= 5 {
+		if err := w.WriteArrayLength(len(m.PartitionStates)); err != nil {
+			return err
+		}
+		for i := range m.PartitionStates {
+			if err := m.PartitionStates[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 6 {
+		if err := w.WriteCompactArrayLength(len(m.PartitionStates)); err != nil {
+			return err
+		}
+		for i := range m.PartitionStates {
+			if err := m.PartitionStates[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 6 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *UpdateMetadataRequestTopicState) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version <= 5 {
+		if m.Topic, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version >= 6 {
+		if m.Topic, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version >= 7 {
+		if m.TopicId, err = r.ReadUuid(); err != nil {
+			return err
+		}
+	}
+	if version <= 5 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field PartitionStates was serialized as null")
+		} else {
+			m.PartitionStates = make([]UpdateMetadataRequestTopicPartition, 0)
+			for i := 0; i < n; i++ {
+				var e UpdateMetadataRequestTopicPartition
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.PartitionStates = append(m.PartitionStates, e)
+			}
+		}
+	} else if version >= 6 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field PartitionStates was serialized as null")
+		} else {
+			m.PartitionStates = make([]UpdateMetadataRequestTopicPartition, 0)
+			for i := 0; i < n; i++ {
+				var e UpdateMetadataRequestTopicPartition
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.PartitionStates = append(m.PartitionStates, e)
+			}
+		}
+	}
+	if version >= 6 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateMetadataRequestLiveBroker is the type of UpdateMetadataRequest.LiveBrokers.
+type UpdateMetadataRequestLiveBroker struct {
+	Id        int32
+	Host      string
+	Port      int32
+	Endpoints []UpdateMetadataRequestLiveBrokerEndpoint
+	Rack      *string
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of UpdateMetadataRequestLiveBroker to their default values.
+func (m *UpdateMetadataRequestLiveBroker) SetDefaults() {
+	*m = UpdateMetadataRequestLiveBroker{}
+}
+
+func (m *UpdateMetadataRequestLiveBroker) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt32(m.Id); err != nil {
+		return err
+	}
+	if version == 0 {
+		if err := w.WriteString(m.Host); err != nil {
+			return err
+		}
+	}
+	if version == 0 {
+		if err := w.WriteInt32(m.Port); err != nil {
+			return err
+		}
+	}
+	if version >= 1 && version <= 5 {
+		if err := w.WriteArrayLength(len(m.Endpoints)); err != nil {
+			return err
+		}
+		for i := range m.Endpoints {
+			if err := m.Endpoints[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	} else if version >= 6 {
+		if err := w.WriteCompactArrayLength(len(m.Endpoints)); err != nil {
+			return err
+		}
+		for i := range m.Endpoints {
+			if err := m.Endpoints[i].encode(w, version); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 2 && version <= 5 {
+		if err := w.WriteNullableString(m.Rack); err != nil {
+			return err
+		}
+	} else if version >= 6 {
+		if err := w.WriteCompactNullableString(m.Rack); err != nil {
+			return err
+		}
+	}
+	if version >= 6 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *UpdateMetadataRequestLiveBroker) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.Id, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version == 0 {
+		if m.Host, err = r.ReadString(); err != nil {
+			return err
+		}
+	}
+	if version == 0 {
+		if m.Port, err = r.ReadInt32(); err != nil {
+			return err
+		}
+	}
+	if version >= 1 && version <= 5 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Endpoints was serialized as null")
+		} else {
+			m.Endpoints = make([]UpdateMetadataRequestLiveBrokerEndpoint, 0)
+			for i := 0; i < n; i++ {
+				var e UpdateMetadataRequestLiveBrokerEndpoint
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Endpoints = append(m.Endpoints, e)
+			}
+		}
+	} else if version >= 6 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Endpoints was serialized as null")
+		} else {
+			m.Endpoints = make([]UpdateMetadataRequestLiveBrokerEndpoint, 0)
+			for i := 0; i < n; i++ {
+				var e UpdateMetadataRequestLiveBrokerEndpoint
+				if err := e.decode(r, version); err != nil {
+					return err
+				}
+				m.Endpoints = append(m.Endpoints, e)
+			}
+		}
+	}
+	if version >= 2 && version <= 5 {
+		if m.Rack, err = r.ReadNullableString(); err != nil {
+			return err
+		}
+	} else if version >= 6 {
+		if m.Rack, err = r.ReadCompactNullableString(); err != nil {
+			return err
+		}
+	}
+	if version >= 6 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateMetadataRequestLiveBrokerEndpoint is the type of UpdateMetadataRequestLiveBroker.Endpoints.
+type UpdateMetadataRequestLiveBrokerEndpoint struct {
+	Port             int32
+	Host             string
+	ListenerName     string
+	SecurityProtocol int16
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of UpdateMetadataRequestLiveBrokerEndpoint to their default values.
+func (m *UpdateMetadataRequestLiveBrokerEndpoint) SetDefaults() {
+	*m = UpdateMetadataRequestLiveBrokerEndpoint{}
+}
+
+func (m *UpdateMetadataRequestLiveBrokerEndpoint) encode(w *protocol.MessageWriter, version int) error {
+	if err := w.WriteInt32(m.Port); err != nil {
+		return err
+	}
+	if version <= 5 {
+		if err := w.WriteString(m.Host); err != nil {
+			return err
+		}
+	} else if version >= 6 {
+		if err := w.WriteCompactString(m.Host); err != nil {
+			return err
+		}
+	}
+	if version >= 3 && version <= 5 {
+		if err := w.WriteString(m.ListenerName); err != nil {
+			return err
+		}
+	} else if version >= 6 {
+		if err := w.WriteCompactString(m.ListenerName); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteInt16(m.SecurityProtocol); err != nil {
+		return err
+	}
+	if version >= 6 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *UpdateMetadataRequestLiveBrokerEndpoint) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if m.Port, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version <= 5 {
+		if m.Host, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version >= 6 {
+		if m.Host, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if version >= 3 && version <= 5 {
+		if m.ListenerName, err = r.ReadString(); err != nil {
+			return err
+		}
+	} else if version >= 6 {
+		if m.ListenerName, err = r.ReadCompactString(); err != nil {
+			return err
+		}
+	}
+	if m.SecurityProtocol, err = r.ReadInt16(); err != nil {
+		return err
+	}
+	if version >= 6 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateMetadataRequestTopicPartition is a common struct of UpdateMetadataRequest.
+type UpdateMetadataRequestTopicPartition struct {
+	Topic           string
+	Partition       int32
+	ControllerEpoch int32
+	Leader          int32
+	LeaderEpoch     int32
+	Isr             []int32
+	ZkVersion       int32
+	Replicas        []int32
+	OfflineReplicas []int32
+
+	// UnknownTaggedFields holds tagged fields that are not known to this version of the generated
+	// code. They are written back out when the struct is encoded.
+	UnknownTaggedFields []protocol.TaggedField
+}
+
+// SetDefaults resets all fields of UpdateMetadataRequestTopicPartition to their default values.
+func (m *UpdateMetadataRequestTopicPartition) SetDefaults() {
+	*m = UpdateMetadataRequestTopicPartition{}
+}
+
+func (m *UpdateMetadataRequestTopicPartition) encode(w *protocol.MessageWriter, version int) error {
+	if version <= 4 {
+		if err := w.WriteString(m.Topic); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteInt32(m.Partition); err != nil {
+		return err
+	}
+	if err := w.WriteInt32(m.ControllerEpoch); err != nil {
+		return err
+	}
+	if err := w.WriteInt32(m.Leader); err != nil {
+		return err
+	}
+	if err := w.WriteInt32(m.LeaderEpoch); err != nil {
+		return err
+	}
+	if version <= 5 {
+		if err := w.WriteArrayLength(len(m.Isr)); err != nil {
+			return err
+		}
+		for i := range m.Isr {
+			if err := w.WriteInt32(m.Isr[i]); err != nil {
+				return err
+			}
+		}
+	} else if version >= 6 {
+		if err := w.WriteCompactArrayLength(len(m.Isr)); err != nil {
+			return err
+		}
+		for i := range m.Isr {
+			if err := w.WriteInt32(m.Isr[i]); err != nil {
+				return err
+			}
+		}
+	}
+	if err := w.WriteInt32(m.ZkVersion); err != nil {
+		return err
+	}
+	if version <= 5 {
+		if err := w.WriteArrayLength(len(m.Replicas)); err != nil {
+			return err
+		}
+		for i := range m.Replicas {
+			if err := w.WriteInt32(m.Replicas[i]); err != nil {
+				return err
+			}
+		}
+	} else if version >= 6 {
+		if err := w.WriteCompactArrayLength(len(m.Replicas)); err != nil {
+			return err
+		}
+		for i := range m.Replicas {
+			if err := w.WriteInt32(m.Replicas[i]); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 4 && version <= 5 {
+		if err := w.WriteArrayLength(len(m.OfflineReplicas)); err != nil {
+			return err
+		}
+		for i := range m.OfflineReplicas {
+			if err := w.WriteInt32(m.OfflineReplicas[i]); err != nil {
+				return err
+			}
+		}
+	} else if version >= 6 {
+		if err := w.WriteCompactArrayLength(len(m.OfflineReplicas)); err != nil {
+			return err
+		}
+		for i := range m.OfflineReplicas {
+			if err := w.WriteInt32(m.OfflineReplicas[i]); err != nil {
+				return err
+			}
+		}
+	}
+	if version >= 6 {
+		if err := w.WriteTaggedFields(m.UnknownTaggedFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *UpdateMetadataRequestTopicPartition) decode(r *protocol.MessageReader, version int) (err error) {
+	m.SetDefaults()
+	if version <= 4 {
+		if m.Topic, err = r.ReadString(); err != nil {
+			return err
+		}
+	}
+	if m.Partition, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if m.ControllerEpoch, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if m.Leader, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if m.LeaderEpoch, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version <= 5 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Isr was serialized as null")
+		} else {
+			m.Isr = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.Isr = append(m.Isr, e)
+			}
+		}
+	} else if version >= 6 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Isr was serialized as null")
+		} else {
+			m.Isr = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.Isr = append(m.Isr, e)
+			}
+		}
+	}
+	if m.ZkVersion, err = r.ReadInt32(); err != nil {
+		return err
+	}
+	if version <= 5 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Replicas was serialized as null")
+		} else {
+			m.Replicas = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.Replicas = append(m.Replicas, e)
+			}
+		}
+	} else if version >= 6 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field Replicas was serialized as null")
+		} else {
+			m.Replicas = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.Replicas = append(m.Replicas, e)
+			}
+		}
+	}
+	if version >= 4 && version <= 5 {
+		if n, err := r.ReadArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field OfflineReplicas was serialized as null")
+		} else {
+			m.OfflineReplicas = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.OfflineReplicas = append(m.OfflineReplicas, e)
+			}
+		}
+	} else if version >= 6 {
+		if n, err := r.ReadCompactArrayLength(); err != nil {
+			return err
+		} else if n < 0 {
+			return fmt.Errorf("non-nullable field OfflineReplicas was serialized as null")
+		} else {
+			m.OfflineReplicas = make([]int32, 0)
+			for i := 0; i < n; i++ {
+				var e int32
+				if e, err = r.ReadInt32(); err != nil {
+					return err
+				}
+				m.OfflineReplicas = append(m.OfflineReplicas, e)
+			}
+		}
+	}
+	if version >= 6 {
+		tagged, err := r.ReadTaggedFields()
+		if err != nil {
+			return err
+		}
+		for _, f := range tagged {
+			switch {
+			default:
+				m.UnknownTaggedFields = append(m.UnknownTaggedFields, f)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,349 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/compress"
+)
+
+const (
+	// legacyMessageOverhead is the size of the offset and size fields that precede a legacy message.
+	legacyMessageOverhead = 12
+	// legacyMessageCrcOffset is the offset of the first byte covered by the CRC of a legacy message.
+	legacyMessageCrcOffset = 16
+)
+
+// LegacyMessage is an entry of a message set with magic v0 or v1, the format used before record
+// batches were introduced in Kafka 0.11.
+type LegacyMessage struct {
+	Offset     int64
+	MagicByte  int8
+	Attributes int8
+	// Timestamp is only present in magic v1.
+	Timestamp int64
+	Key       []byte
+	Value     []byte
+}
+
+func (m *LegacyMessage) Magic() int8 {
+	return m.MagicByte
+}
+
+// Compression returns the compression codec id from the attributes of the message.
+func (m *LegacyMessage) Compression() int {
+	return int(m.Attributes & attributeCompressionMask)
+}
+
+// LogAppendTime reports whether the timestamp of the message was set by the broker.
+func (m *LegacyMessage) LogAppendTime() bool {
+	return m.MagicByte > 0 && m.Attributes&attributeTimestampType != 0
+}
+
+// Encode writes the message. The CRC and message size are computed from the contents.
+func (m *LegacyMessage) Encode(w *MessageWriter) error {
+	if m.MagicByte != 0 && m.MagicByte != 1 {
+		return fmt.Errorf("%w: %d", ErrUnsupportedMagic, m.MagicByte)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(make([]byte, legacyMessageCrcOffset))
+
+	mw := NewMessageWriter(&buf)
+	mw.WriteInt8(m.MagicByte)
+	mw.WriteInt8(m.Attributes)
+	if m.MagicByte == 1 {
+		mw.WriteInt64(m.Timestamp)
+	}
+	mw.WriteNullableBytes(m.Key)
+	mw.WriteNullableBytes(m.Value)
+
+	data := buf.Bytes()
+	binary.BigEndian.PutUint64(data[0:], uint64(m.Offset))
+	binary.BigEndian.PutUint32(data[8:], uint32(len(data)-legacyMessageOverhead))
+	binary.BigEndian.PutUint32(data[12:], Crc32(data[legacyMessageCrcOffset:]))
+
+	_, err := w.Write(data)
+	return err
+}
+
+// Decode reads a message and, unless SkipChecksums is given, verifies its CRC.
+func (m *LegacyMessage) Decode(r *MessageReader, opts ...DecodeOption) error {
+	var head [legacyMessageOverhead]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return err
+	}
+	size := int(int32(binary.BigEndian.Uint32(head[8:])))
+	if size < legacyMessageMinSize(0) {
+		return fmt.Errorf("%w: message size %d", ErrCorruptRecords, size)
+	}
+
+	data, err := r.readN(size)
+	if err != nil {
+		return err
+	}
+	return m.decode(append(head[:], data...), newDecodeOptions(opts))
+}
+
+// decode decodes a complete message, including the offset and size.
+func (m *LegacyMessage) decode(data []byte, opts decodeOptions) error {
+	if len(data)-legacyMessageOverhead < legacyMessageMinSize(0) {
+		return fmt.Errorf("%w: message size %d", ErrCorruptRecords, len(data)-legacyMessageOverhead)
+	}
+	magic := int8(data[magicOffset])
+	if magic != 0 && magic != 1 {
+		return fmt.Errorf("%w: %d", ErrUnsupportedMagic, magic)
+	}
+	if len(data)-legacyMessageOverhead < legacyMessageMinSize(magic) {
+		return fmt.Errorf("%w: message size %d", ErrCorruptRecords, len(data)-legacyMessageOverhead)
+	}
+	offset := int64(binary.BigEndian.Uint64(data[0:]))
+	crc := binary.BigEndian.Uint32(data[12:])
+	if err := opts.verifyChecksum(offset, crc, Crc32, data[legacyMessageCrcOffset:]); err != nil {
+		return err
+	}
+
+	*m = LegacyMessage{
+		Offset:     offset,
+		MagicByte:  magic,
+		Attributes: int8(data[magicOffset+1]),
+	}
+
+	br := bytes.NewReader(data[magicOffset+2:])
+	r := NewMessageReader(br)
+	var err error
+	if magic == 1 {
+		if m.Timestamp, err = r.ReadInt64(); err != nil {
+			return err
+		}
+	}
+	if m.Key, err = r.ReadNullableBytes(); err != nil {
+		return fmt.Errorf("%w: message key: %v", ErrCorruptRecords, err)
+	}
+	if m.Value, err = r.ReadNullableBytes(); err != nil {
+		return fmt.Errorf("%w: message value: %v", ErrCorruptRecords, err)
+	}
+	if br.Len() != 0 {
+		return fmt.Errorf("%w: %d trailing bytes in message", ErrCorruptRecords, br.Len())
+	}
+	return nil
+}
+
+// Decompress returns the messages wrapped by a compressed message. The offsets of the wrapped
+// messages are made absolute, and for magic v1 they take the timestamp of the wrapper if it was set
+// by the broker.
+func (m *LegacyMessage) Decompress(opts ...DecodeOption) ([]*LegacyMessage, error) {
+	o := newDecodeOptions(opts)
+	codec, err := lookupCodec(m.Compression(), m.MagicByte)
+	if err != nil {
+		return nil, err
+	}
+	data, err := codec.Decode(nil, m.Value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: decompressing message: %v", ErrCorruptRecords, err)
+	}
+
+	var messages []*LegacyMessage
+	for len(data) > 0 {
+		if len(data) < legacyMessageOverhead+legacyMessageMinSize(0) {
+			return nil, fmt.Errorf("%w: truncated wrapped message", ErrCorruptRecords)
+		}
+		size := int(int32(binary.BigEndian.Uint32(data[8:])))
+		if size < legacyMessageMinSize(0) || size+legacyMessageOverhead > len(data) {
+			return nil, fmt.Errorf("%w: wrapped message size %d", ErrCorruptRecords, size)
+		}
+
+		inner := new(LegacyMessage)
+		if err := inner.decode(data[:size+legacyMessageOverhead], o); err != nil {
+			return nil, err
+		}
+		if inner.Compression() != 0 {
+			return nil, fmt.Errorf("%w: nested compressed message", ErrCorruptRecords)
+		}
+		messages = append(messages, inner)
+		data = data[size+legacyMessageOverhead:]
+	}
+
+	if m.MagicByte == 1 && len(messages) > 0 {
+		// magic v1 wrapped messages have offsets relative to the first message, and the wrapper has
+		// the offset of the last message
+		delta := m.Offset - messages[len(messages)-1].Offset
+		for _, inner := range messages {
+			inner.Offset += delta
+			if m.LogAppendTime() {
+				inner.Timestamp = m.Timestamp
+				inner.Attributes |= attributeTimestampType
+			}
+		}
+	}
+	return messages, nil
+}
+
+// CompressMessages returns a wrapper message that holds messages compressed with codec. The
+// wrapper has the magic of the first message, and for magic v1 the offsets of the messages are
+// stored relative to the first message.
+func CompressMessages(codec compress.Id, messages []*LegacyMessage) (*LegacyMessage, error) {
+	if len(messages) == 0 {
+		return nil, errors.New("no messages to compress")
+	}
+	first, last := messages[0], messages[len(messages)-1]
+	c, err := lookupCodec(int(codec), first.MagicByte)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapper := &LegacyMessage{
+		Offset:     last.Offset,
+		MagicByte:  first.MagicByte,
+		Attributes: int8(codec) & attributeCompressionMask,
+	}
+
+	var buf bytes.Buffer
+	w := NewMessageWriter(&buf)
+	for _, m := range messages {
+		if m.MagicByte != wrapper.MagicByte {
+			return nil, fmt.Errorf("%w: mixed magic %d and %d", ErrUnsupportedMagic, wrapper.MagicByte, m.MagicByte)
+		}
+		inner := *m
+		if inner.MagicByte == 1 {
+			inner.Offset -= first.Offset
+			wrapper.Timestamp = max64(wrapper.Timestamp, inner.Timestamp)
+		}
+		if err := inner.Encode(w); err != nil {
+			return nil, err
+		}
+	}
+
+	if wrapper.Value, err = c.Encode(nil, buf.Bytes()); err != nil {
+		return nil, err
+	}
+	return wrapper, nil
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func legacyMessageMinSize(magic int8) int {
+	// crc, magic, attributes, key length and value length
+	size := 4 + 1 + 1 + 4 + 4
+	if magic == 1 {
+		size += 8
+	}
+	return size
+}
+
+// RecordSet is an entry of a records field: either a *RecordBatch or a *LegacyMessage.
+type RecordSet interface {
+	Magic() int8
+	Encode(w *MessageWriter) error
+}
+
+// DecodeFetchRecords decodes the records field of a partition of a Fetch response with the given
+// version. Codecs that the version does not allow are refused.
+func DecodeFetchRecords(version int, data []byte, opts ...DecodeOption) ([]RecordSet, error) {
+	return DecodeRecords(data, append(opts[:len(opts):len(opts)], WithApiVersion(Fetch, version))...)
+}
+
+// DecodeRecords decodes the entries of a records field, which may mix record batches and legacy
+// messages. Compressed legacy messages are replaced by the messages they wrap. A partial entry at
+// the end of the data is ignored, as brokers may truncate the last entry of a fetch response.
+func DecodeRecords(data []byte, opts ...DecodeOption) ([]RecordSet, error) {
+	o := newDecodeOptions(opts)
+	var sets []RecordSet
+	for len(data) > magicOffset {
+		size := int(int32(binary.BigEndian.Uint32(data[8:])))
+		// every entry is at least as long as the smallest legacy message, and record batches are
+		// longer
+		if size < legacyMessageMinSize(0) {
+			return nil, fmt.Errorf("%w: entry size %d", ErrCorruptRecords, size)
+		}
+		if size+legacyMessageOverhead > len(data) {
+			break
+		}
+
+		entry := data[:size+legacyMessageOverhead]
+		data = data[size+legacyMessageOverhead:]
+
+		switch magic := int8(entry[magicOffset]); magic {
+		case 0, 1:
+			m := new(LegacyMessage)
+			if err := m.decode(entry, o); err != nil {
+				return nil, err
+			}
+			if m.Compression() == 0 {
+				sets = append(sets, m)
+				break
+			}
+			messages, err := m.Decompress(opts...)
+			if err != nil {
+				return nil, err
+			}
+			for _, inner := range messages {
+				sets = append(sets, inner)
+			}
+		case 2:
+			if len(entry) < recordBatchOverhead {
+				return nil, fmt.Errorf("%w: batch length %d", ErrCorruptRecords, size)
+			}
+			b := new(RecordBatch)
+			if err := b.decode(entry, o); err != nil {
+				return nil, err
+			}
+			sets = append(sets, b)
+		default:
+			return nil, fmt.Errorf("%w: %d", ErrUnsupportedMagic, magic)
+		}
+	}
+	return sets, nil
+}
+
+// EncodeRecords encodes record batches and legacy messages as the contents of a records field.
+func EncodeRecords(sets []RecordSet) ([]byte, error) {
+	var buf bytes.Buffer
+	w := NewMessageWriter(&buf)
+	for _, s := range sets {
+		if err := s.Encode(w); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// DownConvert converts the records of the batch to legacy messages with the given magic, for
+// consumers that do not support record batches. Record headers, which legacy messages cannot
+// carry, are dropped, and timestamps are dropped for magic v0. Control batches have no legacy
+// equivalent and convert to no messages.
+func (b *RecordBatch) DownConvert(magic int8) ([]*LegacyMessage, error) {
+	if magic != 0 && magic != 1 {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedMagic, magic)
+	}
+	if b.Control() {
+		return nil, nil
+	}
+
+	messages := make([]*LegacyMessage, 0, len(b.Records))
+	for _, rec := range b.Records {
+		m := &LegacyMessage{
+			Offset:    b.BaseOffset + int64(rec.OffsetDelta),
+			MagicByte: magic,
+			Key:       rec.Key,
+			Value:     rec.Value,
+		}
+		if magic == 1 {
+			m.Timestamp = b.BaseTimestamp + rec.TimestampDelta
+			if b.LogAppendTime() {
+				m.Attributes |= attributeTimestampType
+				m.Timestamp = b.MaxTimestamp
+			}
+		}
+		messages = append(messages, m)
+	}
+	return messages, nil
+}
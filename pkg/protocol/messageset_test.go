@@ -0,0 +1,109 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/compress"
+)
+
+func encodeLegacyMessage(t *testing.T, m *LegacyMessage) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := m.Encode(NewMessageWriter(&buf)); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecodeRecordsLegacy(t *testing.T) {
+	var data []byte
+	for i, magic := range []int8{0, 1} {
+		data = append(data, encodeLegacyMessage(t, &LegacyMessage{
+			Offset:    int64(i),
+			MagicByte: magic,
+			Timestamp: int64(magic) * 1000,
+			Key:       []byte("k"),
+			Value:     []byte("v"),
+		})...)
+	}
+	// a partial entry at the end is ignored
+	data = append(data, data[:20]...)
+
+	sets, err := DecodeRecords(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sets) != 2 {
+		t.Fatalf("decoded %d entries, want 2", len(sets))
+	}
+	m := sets[1].(*LegacyMessage)
+	if m.Offset != 1 || m.Magic() != 1 || m.Timestamp != 1000 || string(m.Key) != "k" || string(m.Value) != "v" {
+		t.Errorf("decoded %+v", m)
+	}
+}
+
+func TestDecodeRecordsCorrupt(t *testing.T) {
+	message := encodeLegacyMessage(t, &LegacyMessage{Value: []byte("v")})
+	withSize := func(size int32) []byte {
+		data := bytes.Clone(message)
+		binary.BigEndian.PutUint32(data[8:], uint32(size))
+		return data
+	}
+
+	tests := map[string][]byte{
+		"zero size":     make([]byte, 20),
+		"negative size": withSize(-1),
+		"short entry":   withSize(4),
+		"short magic 1": append(make([]byte, 8), 0, 0, 0, 14, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0),
+	}
+	for name, data := range tests {
+		if _, err := DecodeRecords(data, SkipChecksums()); !errors.Is(err, ErrCorruptRecords) {
+			t.Errorf("%s: error = %v, want ErrCorruptRecords", name, err)
+		}
+	}
+}
+
+func TestLegacyMessageDecompress(t *testing.T) {
+	messages := []*LegacyMessage{
+		{Offset: 10, MagicByte: 1, Timestamp: 5, Value: []byte("a")},
+		{Offset: 11, MagicByte: 1, Timestamp: 6, Value: []byte("b")},
+	}
+	wrapper, err := CompressMessages(compress.Gzip, messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sets, err := DecodeRecords(encodeLegacyMessage(t, wrapper))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sets) != 2 {
+		t.Fatalf("decoded %d messages, want 2", len(sets))
+	}
+	for i, set := range sets {
+		if m := set.(*LegacyMessage); m.Offset != messages[i].Offset || string(m.Value) != string(messages[i].Value) {
+			t.Errorf("message %d = %+v, want %+v", i, m, messages[i])
+		}
+	}
+
+	// a wrapped message whose size is too small for a message
+	inner := encodeLegacyMessage(t, &LegacyMessage{Value: []byte("a")})
+	binary.BigEndian.PutUint32(inner[8:], 4)
+	codec, _ := lookupCodec(int(compress.Gzip), 0)
+	wrapper = &LegacyMessage{Attributes: int8(compress.Gzip)}
+	if wrapper.Value, err = codec.Encode(nil, inner); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wrapper.Decompress(SkipChecksums()); !errors.Is(err, ErrCorruptRecords) {
+		t.Errorf("error = %v, want ErrCorruptRecords", err)
+	}
+}
+
+func TestLegacyMessageDecodeShort(t *testing.T) {
+	var m LegacyMessage
+	if err := m.decode(make([]byte, legacyMessageOverhead), decodeOptions{}); !errors.Is(err, ErrCorruptRecords) {
+		t.Errorf("error = %v, want ErrCorruptRecords", err)
+	}
+}
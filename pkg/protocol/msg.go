@@ -1,10 +1,21 @@
 package protocol
 
+import "errors"
+
 type Message interface {
 	Marshal() []byte
 	Unmarshal([]byte) error
+	Encode(*MessageWriter) error
+	Decode(*MessageReader) error
 
 	ApiKey() ApiKey
 	Version() int
+	SetVersion(int)
 	CorrelationId() int
+	SetCorrelationId(int)
 }
+
+// ErrVersionNotImplemented is returned when a message is encoded or decoded at a version that this
+// library does not implement. Brokers report versions they do not support with the
+// ErrUnsupportedVersion error code instead.
+var ErrVersionNotImplemented = errors.New("version not implemented")
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math"
 
 	"github.com/google/uuid"
 )
@@ -21,6 +22,11 @@ func (r *MessageReader) Read(p []byte) (int, error) {
 	return r.reader.Read(p)
 }
 
+func (r *MessageReader) ReadBool() (res bool, err error) {
+	v, err := r.ReadInt8()
+	return v != 0, err
+}
+
 func (r *MessageReader) ReadInt8() (res int8, err error) {
 	err = readShared(r.reader, r.buf[:1], &res)
 	return
@@ -31,6 +37,11 @@ func (r *MessageReader) ReadInt16() (res int16, err error) {
 	return
 }
 
+func (r *MessageReader) ReadUint16() (res uint16, err error) {
+	err = readShared(r.reader, r.buf[:2], &res)
+	return
+}
+
 func (r *MessageReader) ReadInt32() (res int32, err error) {
 	err = readShared(r.reader, r.buf[:4], &res)
 	return
@@ -46,18 +57,25 @@ func (r *MessageReader) ReadUint32() (res uint32, err error) {
 	return
 }
 
+func (r *MessageReader) ReadUnsignedVarInt() (uint32, error) {
+	ret, err := readVarInt(r.reader, r.buf[:1], 5)
+	return uint32(ret), err
+}
+
+// ReadVarInt reads a zigzag encoded signed varint.
 func (r *MessageReader) ReadVarInt() (int32, error) {
-	ret, err := readVarInt(r.reader, 5)
-	return int32(ret), err
+	ret, err := readVarInt(r.reader, r.buf[:1], 5)
+	return int32(uint32(ret)>>1) ^ -int32(ret&1), err
 }
 
+// ReadVarLong reads a zigzag encoded signed varlong.
 func (r *MessageReader) ReadVarLong() (int64, error) {
-	return readVarInt(r.reader, 10)
+	ret, err := readVarInt(r.reader, r.buf[:1], 10)
+	return int64(ret>>1) ^ -int64(ret&1), err
 }
 
 func (r *MessageReader) ReadUuid() (uuid.UUID, error) {
-	_, err := r.reader.Read(r.buf[:])
-	if err != nil {
+	if _, err := io.ReadFull(r.reader, r.buf[:]); err != nil {
 		return uuid.UUID{}, err
 	}
 
@@ -75,13 +93,12 @@ func (r *MessageReader) ReadString() (str string, err error) {
 		return
 	}
 
-	if length <= 0 {
+	if length < 0 {
 		err = fmt.Errorf("non-nullable string encoded with invalid length %d", length)
 		return
 	}
 
-	buf := make([]byte, length)
-	_, err = r.reader.Read(buf)
+	buf, err := r.readN(int(length))
 	if err != nil {
 		return
 	}
@@ -90,19 +107,17 @@ func (r *MessageReader) ReadString() (str string, err error) {
 }
 
 func (r *MessageReader) ReadCompactString() (str string, err error) {
-	l, err := r.ReadVarInt()
+	l, err := r.ReadUnsignedVarInt()
 	if err != nil {
 		return
 	}
 
-	length := l - 1
-	if length-1 <= 0 {
-		err = fmt.Errorf("non-nullable compact string encoded with invalid length %d", length)
+	if l == 0 {
+		err = fmt.Errorf("non-nullable compact string encoded as null")
 		return
 	}
 
-	buf := make([]byte, length)
-	_, err = r.reader.Read(buf)
+	buf, err := r.readN(int(l - 1))
 	if err != nil {
 		return
 	}
@@ -110,39 +125,34 @@ func (r *MessageReader) ReadCompactString() (str string, err error) {
 	return string(buf), nil
 }
 
-func (r *MessageReader) ReadNullableString() (str string, err error) {
+func (r *MessageReader) ReadNullableString() (str *string, err error) {
 	length, err := r.ReadInt16()
-	if err != nil || length <= 0 {
+	if err != nil || length < 0 {
 		return
 	}
 
-	buf := make([]byte, length)
-	_, err = r.reader.Read(buf)
+	buf, err := r.readN(int(length))
 	if err != nil {
 		return
 	}
 
-	return string(buf), nil
+	s := string(buf)
+	return &s, nil
 }
 
-func (r *MessageReader) ReadCompactNullableString() (str string, err error) {
-	l, err := r.ReadVarInt()
-	if err != nil {
-		return
-	}
-
-	length := l - 1
-	if length <= 0 {
+func (r *MessageReader) ReadCompactNullableString() (str *string, err error) {
+	l, err := r.ReadUnsignedVarInt()
+	if err != nil || l == 0 {
 		return
 	}
 
-	buf := make([]byte, length)
-	_, err = r.reader.Read(buf)
+	buf, err := r.readN(int(l - 1))
 	if err != nil {
 		return
 	}
 
-	return string(buf), nil
+	s := string(buf)
+	return &s, nil
 }
 
 func (r *MessageReader) ReadBytes() (buf []byte, err error) {
@@ -151,86 +161,129 @@ func (r *MessageReader) ReadBytes() (buf []byte, err error) {
 		return
 	}
 
-	if length <= 0 {
-		err = fmt.Errorf("non-nullable string encoded with invalid length %d", length)
+	if length < 0 {
+		err = fmt.Errorf("non-nullable bytes encoded with invalid length %d", length)
 		return
 	}
 
-	buf = make([]byte, length)
-	_, err = r.reader.Read(buf)
-	if err != nil {
-		return
-	}
-
-	return
+	return r.readN(int(length))
 }
 
 func (r *MessageReader) ReadCompactBytes() (buf []byte, err error) {
-	l, err := r.ReadVarInt()
+	l, err := r.ReadUnsignedVarInt()
 	if err != nil {
 		return
 	}
 
-	length := l - 1
-	if length-1 <= 0 {
-		err = fmt.Errorf("non-nullable compact string encoded with invalid length %d", length)
+	if l == 0 {
+		err = fmt.Errorf("non-nullable compact bytes encoded as null")
 		return
 	}
 
-	buf = make([]byte, length)
-	_, err = r.reader.Read(buf)
-	if err != nil {
-		return
-	}
-
-	return
+	return r.readN(int(l - 1))
 }
 
 func (r *MessageReader) ReadNullableBytes() (buf []byte, err error) {
 	length, err := r.ReadInt32()
-	if err != nil || length <= 0 {
+	if err != nil || length < 0 {
 		return
 	}
 
-	buf = make([]byte, length)
-	_, err = r.reader.Read(buf)
-	if err != nil {
+	return r.readN(int(length))
+}
+
+func (r *MessageReader) ReadCompactNullableBytes() (buf []byte, err error) {
+	l, err := r.ReadUnsignedVarInt()
+	if err != nil || l == 0 {
 		return
 	}
 
-	return
+	return r.readN(int(l - 1))
 }
 
-func (r *MessageReader) ReadCompactNullableBytes() (buf []byte, err error) {
-	l, err := r.ReadVarInt()
+func (r *MessageReader) ReadRecords() ([]byte, error) {
+	return r.ReadNullableBytes()
+}
+
+func (r *MessageReader) ReadCompactRecords() ([]byte, error) {
+	return r.ReadCompactNullableBytes()
+}
+
+// ReadArrayLength reads the length of an array, which is -1 for a null array.
+func (r *MessageReader) ReadArrayLength() (int, error) {
+	length, err := r.ReadInt32()
 	if err != nil {
-		return
+		return 0, err
 	}
 
-	length := l - 1
-	if length <= 0 {
-		return
+	if length < -1 {
+		return 0, fmt.Errorf("array encoded with invalid length %d", length)
 	}
 
-	buf = make([]byte, length)
-	_, err = r.reader.Read(buf)
+	return int(length), nil
+}
+
+// ReadCompactArrayLength reads the length of a compact array, which is -1 for a null array.
+func (r *MessageReader) ReadCompactArrayLength() (int, error) {
+	l, err := r.ReadUnsignedVarInt()
 	if err != nil {
-		return
+		return 0, err
 	}
 
-	return
+	return int(l) - 1, nil
 }
 
-func (r *MessageReader) ReadRecords() ([]byte, error) {
-	return r.ReadNullableBytes()
+// ReadTaggedFields reads the tagged field section of a flexible version struct.
+func (r *MessageReader) ReadTaggedFields() ([]TaggedField, error) {
+	count, err := r.ReadUnsignedVarInt()
+	if err != nil || count == 0 {
+		return nil, err
+	}
+
+	var fields []TaggedField
+	for i := uint32(0); i < count; i++ {
+		tag, err := r.ReadUnsignedVarInt()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(fields) > 0 && int(tag) <= fields[len(fields)-1].Tag {
+			return nil, fmt.Errorf("tagged field %d is out of order or duplicated", tag)
+		}
+
+		size, err := r.ReadUnsignedVarInt()
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := r.readN(int(size))
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, TaggedField{Tag: int(tag), Data: data})
+	}
+
+	return fields, nil
 }
 
-func (r *MessageReader) ReadCompactRecords() ([]byte, error) {
-	return r.ReadCompactNullableBytes()
+// readN reads exactly length bytes. Lengths that are larger than the remaining input are
+// rejected before allocating when the underlying reader knows its size (e.g. bytes.Reader).
+func (r *MessageReader) readN(length int) ([]byte, error) {
+	if sized, ok := r.reader.(interface{ Len() int }); ok && length > sized.Len() {
+		return nil, fmt.Errorf("length %d exceeds remaining %d bytes: %w", length, sized.Len(), io.ErrUnexpectedEOF)
+	}
+
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r.reader, buf); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
 }
 
 func readShared(reader io.Reader, target []byte, val any) error {
-	if _, err := reader.Read(target); err != nil {
+	if _, err := io.ReadFull(reader, target); err != nil {
 		return err
 	}
 
@@ -239,6 +292,8 @@ func readShared(reader io.Reader, target []byte, val any) error {
 		*v = int8(target[0])
 	case *int16:
 		*v = int16(binary.BigEndian.Uint16(target))
+	case *uint16:
+		*v = binary.BigEndian.Uint16(target)
 	case *int32:
 		*v = int32(binary.BigEndian.Uint32(target))
 	case *uint32:
@@ -246,7 +301,7 @@ func readShared(reader io.Reader, target []byte, val any) error {
 	case *int64:
 		*v = int64(binary.BigEndian.Uint64(target))
 	case *float64:
-		*v = float64(binary.BigEndian.Uint64(target))
+		*v = math.Float64frombits(binary.BigEndian.Uint64(target))
 	default:
 		return fmt.Errorf("can't read object of type %T", v)
 	}
@@ -254,24 +309,18 @@ func readShared(reader io.Reader, target []byte, val any) error {
 	return nil
 }
 
-func readVarInt(reader io.Reader, sz int) (res int64, err error) {
-	bytes := make([]byte, 0, sz)
-
-	var b [1]byte
-	for _, err = reader.Read(b[:]); err != nil && b[0]&0x80 > 0 && len(bytes) < cap(bytes); _, err = reader.Read(b[:]) {
-		bytes = append([]byte{(b[0] << 1) >> 1}, bytes...)
-	}
-
-	if err != nil {
-		return
+// readVarInt reads an unsigned base 128 varint of at most sz bytes, using b as a one byte buffer.
+func readVarInt(reader io.Reader, b []byte, sz int) (res uint64, err error) {
+	for i := 0; i < sz; i++ {
+		if _, err = io.ReadFull(reader, b[:1]); err != nil {
+			return
+		}
+
+		res |= uint64(b[0]&0x7F) << (7 * i)
+		if b[0]&0x80 == 0 {
+			return
+		}
 	}
 
-	bytes = append([]byte{(b[0] << 1) >> 1}, bytes...)
-
-	for _, b[0] = range bytes {
-		res <<= 7
-		res |= int64(b[0])
-	}
-
-	return
+	return 0, fmt.Errorf("varint is longer than %d bytes", sz)
 }
@@ -0,0 +1,399 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/compress"
+)
+
+const (
+	// recordBatchOverhead is the size of the record batch fields up to and including the record
+	// count.
+	recordBatchOverhead = 61
+	// recordBatchCrcOffset is the offset of the first byte covered by the CRC of a record batch.
+	recordBatchCrcOffset = 21
+	// magicOffset is the offset of the magic byte in both record batches and legacy messages.
+	magicOffset = 16
+)
+
+const (
+	attributeCompressionMask = 0x07
+	attributeTimestampType   = 0x08
+	attributeTransactional   = 0x10
+	attributeControl         = 0x20
+)
+
+var (
+	ErrCorruptRecords         = errors.New("corrupt records")
+	ErrUnsupportedMagic       = errors.New("unsupported magic")
+	ErrUnsupportedCompression = errors.New("unsupported compression")
+)
+
+// DecodeOption configures the decoding of record batches and legacy messages.
+type DecodeOption func(*decodeOptions)
+
+type decodeOptions struct {
+	apiKey        ApiKey
+	apiVersion    int
+	skipChecksums bool
+}
+
+// WithApiVersion declares the API and version of the message that the records were read from, so
+// that codecs the version does not allow are refused. See CheckCompression.
+func WithApiVersion(key ApiKey, version int) DecodeOption {
+	return func(o *decodeOptions) {
+		o.apiKey = key
+		o.apiVersion = version
+	}
+}
+
+func newDecodeOptions(opts []DecodeOption) decodeOptions {
+	o := decodeOptions{apiKey: -1}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// checkCompression returns an error if the codec is not allowed in the declared API version.
+func (o decodeOptions) checkCompression(id compress.Id) error {
+	return CheckCompression(o.apiKey, o.apiVersion, id)
+}
+
+// CheckCompression returns an ErrUnsupportedCompression error if records compressed with the codec
+// may not be sent in the given version of an API, as the broker would: zstd requires Produce v7 or
+// Fetch v10.
+func CheckCompression(key ApiKey, version int, id compress.Id) error {
+	if id != compress.Zstd {
+		return nil
+	}
+
+	var minVersion int
+	switch key {
+	case Produce:
+		minVersion = 7
+	case Fetch:
+		minVersion = 10
+	default:
+		return nil
+	}
+	if version < minVersion {
+		return fmt.Errorf("%w: %v requires %v v%d or later, got v%d", ErrUnsupportedCompression, id, key, minVersion, version)
+	}
+	return nil
+}
+
+// RecordHeader is a header of a record.
+type RecordHeader struct {
+	Key   string
+	Value []byte
+}
+
+// Record is a record of a v2 record batch. Its offset and timestamp are relative to the batch.
+type Record struct {
+	Attributes     int8
+	TimestampDelta int64
+	OffsetDelta    int32
+	Key            []byte
+	Value          []byte
+	Headers        []RecordHeader
+}
+
+// RecordBatch is a record batch with magic v2, the format used by Produce v3+ and Fetch v4+.
+type RecordBatch struct {
+	BaseOffset           int64
+	PartitionLeaderEpoch int32
+	Attributes           int16
+	LastOffsetDelta      int32
+	BaseTimestamp        int64
+	MaxTimestamp         int64
+	ProducerId           int64
+	ProducerEpoch        int16
+	BaseSequence         int32
+	Records              []Record
+}
+
+func (b *RecordBatch) Magic() int8 {
+	return 2
+}
+
+// Compression returns the compression codec id from the attributes of the batch.
+func (b *RecordBatch) Compression() int {
+	return int(b.Attributes & attributeCompressionMask)
+}
+
+// LogAppendTime reports whether the timestamps of the batch were set by the broker.
+func (b *RecordBatch) LogAppendTime() bool {
+	return b.Attributes&attributeTimestampType != 0
+}
+
+func (b *RecordBatch) Transactional() bool {
+	return b.Attributes&attributeTransactional != 0
+}
+
+func (b *RecordBatch) Control() bool {
+	return b.Attributes&attributeControl != 0
+}
+
+// Encode writes the batch. The CRC and batch length are computed from the contents.
+func (b *RecordBatch) Encode(w *MessageWriter) error {
+	var records bytes.Buffer
+	rw := NewMessageWriter(&records)
+	for i := range b.Records {
+		if err := b.Records[i].encode(rw); err != nil {
+			return err
+		}
+	}
+
+	buf := make([]byte, recordBatchOverhead, recordBatchOverhead+records.Len())
+	if b.Compression() == 0 {
+		buf = append(buf, records.Bytes()...)
+	} else {
+		codec, err := lookupCodec(b.Compression(), 2)
+		if err != nil {
+			return err
+		}
+		if buf, err = codec.Encode(buf, records.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	binary.BigEndian.PutUint64(buf[0:], uint64(b.BaseOffset))
+	binary.BigEndian.PutUint32(buf[8:], uint32(len(buf)-12))
+	binary.BigEndian.PutUint32(buf[12:], uint32(b.PartitionLeaderEpoch))
+	buf[magicOffset] = 2
+	binary.BigEndian.PutUint16(buf[21:], uint16(b.Attributes))
+	binary.BigEndian.PutUint32(buf[23:], uint32(b.LastOffsetDelta))
+	binary.BigEndian.PutUint64(buf[27:], uint64(b.BaseTimestamp))
+	binary.BigEndian.PutUint64(buf[35:], uint64(b.MaxTimestamp))
+	binary.BigEndian.PutUint64(buf[43:], uint64(b.ProducerId))
+	binary.BigEndian.PutUint16(buf[51:], uint16(b.ProducerEpoch))
+	binary.BigEndian.PutUint32(buf[53:], uint32(b.BaseSequence))
+	binary.BigEndian.PutUint32(buf[57:], uint32(len(b.Records)))
+	binary.BigEndian.PutUint32(buf[17:], Crc32c(buf[recordBatchCrcOffset:]))
+
+	_, err := w.Write(buf)
+	return err
+}
+
+// Decode reads a batch and, unless SkipChecksums is given, verifies its CRC.
+func (b *RecordBatch) Decode(r *MessageReader, opts ...DecodeOption) error {
+	var head [12]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return err
+	}
+	length := int(int32(binary.BigEndian.Uint32(head[8:])))
+	if length < recordBatchOverhead-12 {
+		return fmt.Errorf("%w: batch length %d", ErrCorruptRecords, length)
+	}
+
+	data, err := r.readN(length)
+	if err != nil {
+		return err
+	}
+	return b.decode(append(head[:], data...), newDecodeOptions(opts))
+}
+
+// decode decodes a complete batch, including the base offset and length.
+func (b *RecordBatch) decode(data []byte, opts decodeOptions) error {
+	if magic := int8(data[magicOffset]); magic != 2 {
+		return fmt.Errorf("%w: %d", ErrUnsupportedMagic, magic)
+	}
+	baseOffset := int64(binary.BigEndian.Uint64(data[0:]))
+	crc := binary.BigEndian.Uint32(data[17:])
+	if err := opts.verifyChecksum(baseOffset, crc, Crc32c, data[recordBatchCrcOffset:]); err != nil {
+		return err
+	}
+
+	*b = RecordBatch{
+		BaseOffset:           baseOffset,
+		PartitionLeaderEpoch: int32(binary.BigEndian.Uint32(data[12:])),
+	}
+
+	b.Attributes = int16(binary.BigEndian.Uint16(data[21:]))
+	b.LastOffsetDelta = int32(binary.BigEndian.Uint32(data[23:]))
+	b.BaseTimestamp = int64(binary.BigEndian.Uint64(data[27:]))
+	b.MaxTimestamp = int64(binary.BigEndian.Uint64(data[35:]))
+	b.ProducerId = int64(binary.BigEndian.Uint64(data[43:]))
+	b.ProducerEpoch = int16(binary.BigEndian.Uint16(data[51:]))
+	b.BaseSequence = int32(binary.BigEndian.Uint32(data[53:]))
+	count := int32(binary.BigEndian.Uint32(data[57:]))
+
+	records := data[recordBatchOverhead:]
+	if err := opts.checkCompression(compress.Id(b.Compression())); err != nil {
+		return err
+	}
+	if b.Compression() != 0 {
+		codec, err := lookupCodec(b.Compression(), 2)
+		if err != nil {
+			return err
+		}
+		if records, err = codec.Decode(nil, records); err != nil {
+			return fmt.Errorf("%w: decompressing batch: %v", ErrCorruptRecords, err)
+		}
+	}
+	if count < 0 || int(count) > len(records) {
+		return fmt.Errorf("%w: record count %d", ErrCorruptRecords, count)
+	}
+
+	r := NewMessageReader(bytes.NewReader(records))
+	b.Records = make([]Record, count)
+	for i := range b.Records {
+		if err := b.Records[i].decode(r); err != nil {
+			return fmt.Errorf("%w: record %d: %v", ErrCorruptRecords, i, err)
+		}
+	}
+	return nil
+}
+
+func lookupCodec(id int, magic int8) (compress.Codec, error) {
+	if compress.Id(id) == compress.Zstd && magic < 2 {
+		return nil, fmt.Errorf("%w: %v requires magic v2", ErrUnsupportedCompression, compress.Zstd)
+	}
+	codec, err := compress.LookupMagic(compress.Id(id), magic)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedCompression, compress.Id(id))
+	}
+	return codec, nil
+}
+
+func (rec *Record) encode(w *MessageWriter) error {
+	var buf bytes.Buffer
+	rw := NewMessageWriter(&buf)
+	rw.WriteInt8(rec.Attributes)
+	rw.WriteVarLong(rec.TimestampDelta)
+	rw.WriteVarInt(rec.OffsetDelta)
+	writeVarBytes(rw, rec.Key)
+	writeVarBytes(rw, rec.Value)
+	rw.WriteVarInt(int32(len(rec.Headers)))
+	for _, h := range rec.Headers {
+		rw.WriteVarInt(int32(len(h.Key)))
+		rw.Write([]byte(h.Key))
+		writeVarBytes(rw, h.Value)
+	}
+
+	if err := w.WriteVarInt(int32(buf.Len())); err != nil {
+		return err
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func (rec *Record) decode(r *MessageReader) (err error) {
+	length, err := r.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	if length < 0 {
+		return fmt.Errorf("invalid record length %d", length)
+	}
+	data, err := r.readN(int(length))
+	if err != nil {
+		return err
+	}
+
+	br := bytes.NewReader(data)
+	rr := NewMessageReader(br)
+	if rec.Attributes, err = rr.ReadInt8(); err != nil {
+		return err
+	}
+	if rec.TimestampDelta, err = rr.ReadVarLong(); err != nil {
+		return err
+	}
+	if rec.OffsetDelta, err = rr.ReadVarInt(); err != nil {
+		return err
+	}
+	if rec.Key, err = readVarBytes(rr); err != nil {
+		return err
+	}
+	if rec.Value, err = readVarBytes(rr); err != nil {
+		return err
+	}
+
+	count, err := rr.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	if count < 0 || int(count) > len(data) {
+		return fmt.Errorf("invalid header count %d", count)
+	}
+	rec.Headers = make([]RecordHeader, count)
+	for i := range rec.Headers {
+		key, err := readVarBytes(rr)
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			return errors.New("null header key")
+		}
+		rec.Headers[i].Key = string(key)
+		if rec.Headers[i].Value, err = readVarBytes(rr); err != nil {
+			return err
+		}
+	}
+
+	if br.Len() != 0 {
+		return fmt.Errorf("%d trailing bytes", br.Len())
+	}
+	return nil
+}
+
+// writeVarBytes writes a byte slice with a varint length, where nil is written as -1.
+func writeVarBytes(w *MessageWriter, b []byte) error {
+	if b == nil {
+		return w.WriteVarInt(-1)
+	}
+	if err := w.WriteVarInt(int32(len(b))); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+func readVarBytes(r *MessageReader) ([]byte, error) {
+	length, err := r.ReadVarInt()
+	if err != nil || length < 0 {
+		return nil, err
+	}
+	return r.readN(int(length))
+}
+
+// DecodeRecordBatches decodes the record batches of a records field. A partial batch at the end
+// of the data is ignored, as brokers may truncate the last batch of a fetch response.
+func DecodeRecordBatches(data []byte, opts ...DecodeOption) ([]*RecordBatch, error) {
+	o := newDecodeOptions(opts)
+	var batches []*RecordBatch
+	for len(data) >= recordBatchOverhead {
+		length := int(int32(binary.BigEndian.Uint32(data[8:])))
+		if length < recordBatchOverhead-12 {
+			return nil, fmt.Errorf("%w: batch length %d", ErrCorruptRecords, length)
+		}
+		if length+12 > len(data) {
+			break
+		}
+
+		b := new(RecordBatch)
+		if err := b.decode(data[:length+12], o); err != nil {
+			return nil, err
+		}
+		batches = append(batches, b)
+		data = data[length+12:]
+	}
+	return batches, nil
+}
+
+// EncodeRecordBatches encodes record batches as the contents of a records field.
+func EncodeRecordBatches(batches []*RecordBatch) ([]byte, error) {
+	var buf bytes.Buffer
+	w := NewMessageWriter(&buf)
+	for _, b := range batches {
+		if err := b.Encode(w); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,209 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethanmoffat/kafka-protocol/pkg/compress"
+)
+
+// The golden batches are the first batches of the fixtures/v2-v2.hex and fixtures/v2c-v2c.hex
+// fetch responses of kafka-go, captured from a broker.
+const (
+	goldenBatch = `
+		00000000000000000000008a00000000023978fc3b0000000000010000017c4f173eb90000017c4f173ed2ffffffffff
+		ffffffffffffffffff00000002580000000a616c706861427b22636f756e74223a302c2266696c6c6572223a22616161
+		61616161616161227d00560032020862657461427b22636f756e74223a302c2266696c6c6572223a2262626262626262
+		626262227d00`
+	goldenGzipBatch = `
+		00000000000000000000007900000000021ad503db0001000000010000017c4f1a1f540000017c4f1a1f70ffffffffff
+		ffffffffffffffffff000000021f8b08000000000000008b606060e04acc29c84874aa564ace2fcd2b51b232d0514acb
+		ccc9492d52b2524a8403a55a8630060b268ea4d4121c6a93e000a816009fa88cc759000000`
+)
+
+func unhex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(strings.Join(strings.Fields(s), ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func encodeBatch(t *testing.T, b *RecordBatch) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := b.Encode(NewMessageWriter(&buf)); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestRecordBatchGolden(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          string
+		attributes    int16
+		baseTimestamp int64
+		maxTimestamp  int64
+	}{
+		{"uncompressed", goldenBatch, 0, 1633414495929, 1633414495954},
+		{"gzip", goldenGzipBatch, int16(compress.Gzip), 1633414684500, 1633414684528},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := unhex(t, tt.data)
+			var b RecordBatch
+			if err := b.Decode(NewMessageReader(bytes.NewReader(data))); err != nil {
+				t.Fatal(err)
+			}
+			want := RecordBatch{
+				PartitionLeaderEpoch: 0,
+				Attributes:           tt.attributes,
+				LastOffsetDelta:      1,
+				BaseTimestamp:        tt.baseTimestamp,
+				MaxTimestamp:         tt.maxTimestamp,
+				ProducerId:           -1,
+				ProducerEpoch:        -1,
+				BaseSequence:         -1,
+				Records: []Record{
+					{Key: []byte("alpha"), Value: []byte(`{"count":0,"filler":"aaaaaaaaaa"}`), Headers: []RecordHeader{}},
+					{TimestampDelta: tt.maxTimestamp - tt.baseTimestamp, OffsetDelta: 1, Key: []byte("beta"), Value: []byte(`{"count":0,"filler":"bbbbbbbbbb"}`), Headers: []RecordHeader{}},
+				},
+			}
+			if !reflect.DeepEqual(b, want) {
+				t.Errorf("decoded\n%+v\nwant\n%+v", b, want)
+			}
+
+			// the encoding of the compressed batch depends on the gzip implementation
+			if b.Compression() == 0 {
+				if got := encodeBatch(t, &b); !bytes.Equal(got, data) {
+					t.Errorf("encoded\n%x\nwant\n%x", got, data)
+				}
+			}
+		})
+	}
+}
+
+func TestRecordBatchRoundTrip(t *testing.T) {
+	records := []Record{
+		{Key: []byte("k"), Value: []byte("v")},
+		// a null key and an empty value
+		{TimestampDelta: 300, OffsetDelta: 1, Value: []byte{}},
+		// an empty key and a null value, as in a tombstone
+		{TimestampDelta: -5, OffsetDelta: 2, Key: []byte{}},
+		{OffsetDelta: 3, Value: bytes.Repeat([]byte("x"), 1000), Headers: []RecordHeader{
+			{Key: "a", Value: []byte("1")},
+			{Key: "", Value: nil},
+			{Key: "c", Value: []byte{}},
+		}},
+	}
+	for _, id := range []compress.Id{compress.None, compress.Gzip, compress.Snappy, compress.Lz4, compress.Zstd} {
+		t.Run(id.String(), func(t *testing.T) {
+			b := &RecordBatch{
+				BaseOffset:           1 << 40,
+				PartitionLeaderEpoch: 7,
+				Attributes:           int16(id) | attributeTimestampType,
+				LastOffsetDelta:      3,
+				BaseTimestamp:        1700000000000,
+				MaxTimestamp:         1700000000300,
+				ProducerId:           42,
+				ProducerEpoch:        3,
+				BaseSequence:         100,
+				Records:              records,
+			}
+			data := encodeBatch(t, b)
+
+			batches, err := DecodeRecordBatches(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(batches) != 1 {
+				t.Fatalf("decoded %d batches, want 1", len(batches))
+			}
+			got := batches[0]
+			// decoding gives empty slices for records without headers
+			for i := range got.Records {
+				if len(got.Records[i].Headers) == 0 && b.Records[i].Headers == nil {
+					got.Records[i].Headers = nil
+				}
+			}
+			if !reflect.DeepEqual(got, b) {
+				t.Errorf("decoded\n%+v\nwant\n%+v", got, b)
+			}
+			if !got.LogAppendTime() || got.Compression() != int(id) {
+				t.Errorf("attributes %x", got.Attributes)
+			}
+		})
+	}
+}
+
+func TestRecordBatchControl(t *testing.T) {
+	// a commit marker: the key holds the version and type (1), the value holds the version and the
+	// coordinator epoch
+	b := &RecordBatch{
+		Attributes:    attributeTransactional | attributeControl,
+		ProducerId:    1000,
+		ProducerEpoch: 2,
+		BaseSequence:  -1,
+		Records:       []Record{{Key: []byte{0, 0, 0, 1}, Value: []byte{0, 0, 0, 0, 0, 5}}},
+	}
+	batches, err := DecodeRecordBatches(encodeBatch(t, b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := batches[0]
+	if !got.Transactional() || !got.Control() || got.LogAppendTime() || got.Compression() != 0 {
+		t.Errorf("attributes %x", got.Attributes)
+	}
+	if got.ProducerId != 1000 || got.ProducerEpoch != 2 || !bytes.Equal(got.Records[0].Key, []byte{0, 0, 0, 1}) {
+		t.Errorf("decoded %+v", got)
+	}
+}
+
+func TestCrc32c(t *testing.T) {
+	// the check value of CRC-32C
+	if got := Crc32c([]byte("123456789")); got != 0xe3069283 {
+		t.Errorf("Crc32c() = %08x, want e3069283", got)
+	}
+}
+
+func TestZstdApiVersion(t *testing.T) {
+	data := encodeBatch(t, &RecordBatch{
+		Attributes: int16(compress.Zstd),
+		Records:    []Record{{Value: []byte("v")}},
+	})
+	tests := []struct {
+		key     ApiKey
+		version int
+		ok      bool
+	}{
+		{Produce, 6, false},
+		{Produce, 7, true},
+		{Fetch, 9, false},
+		{Fetch, 10, true},
+	}
+	for _, tt := range tests {
+		err := CheckCompression(tt.key, tt.version, compress.Zstd)
+		if (err == nil) != tt.ok || err != nil && !errors.Is(err, ErrUnsupportedCompression) {
+			t.Errorf("CheckCompression(%v, %d) = %v", tt.key, tt.version, err)
+		}
+		if _, err := DecodeRecordBatches(data, WithApiVersion(tt.key, tt.version)); (err == nil) != tt.ok {
+			t.Errorf("DecodeRecordBatches() at %v v%d = %v", tt.key, tt.version, err)
+		}
+		if tt.key == Fetch {
+			if _, err := DecodeFetchRecords(tt.version, data); (err == nil) != tt.ok {
+				t.Errorf("DecodeFetchRecords(%d) = %v", tt.version, err)
+			}
+		}
+	}
+
+	// other codecs are allowed at every version
+	if err := CheckCompression(Fetch, 0, compress.Lz4); err != nil {
+		t.Errorf("CheckCompression(lz4) = %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var ErrUnknownApiKey = errors.New("unknown api key")
+
+// Api describes the request and response messages of an API key.
+type Api struct {
+	Key        ApiKey
+	MinVersion int
+	MaxVersion int
+	// FlexibleVersion is the first version that uses the flexible (KIP-482) encoding, or -1 if no
+	// version is flexible.
+	FlexibleVersion int
+
+	NewRequest  func(version int) Message
+	NewResponse func(version int) Message
+}
+
+// Supports reports whether version is a valid version of the API.
+func (a Api) Supports(version int) bool {
+	return version >= a.MinVersion && version <= a.MaxVersion
+}
+
+// IsFlexible reports whether version of the API uses the flexible encoding.
+func (a Api) IsFlexible(version int) bool {
+	return a.FlexibleVersion >= 0 && version >= a.FlexibleVersion
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[ApiKey]*Api{}
+)
+
+// RegisterRequest registers the request message of an API. It is called by the generated request
+// types.
+func RegisterRequest(key ApiKey, minVersion, maxVersion, flexibleVersion int, newRequest func(version int) Message) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	api := lookupLocked(key)
+	if api.NewRequest != nil {
+		panic(fmt.Sprintf("protocol: request for %v registered twice", key))
+	}
+	api.MinVersion = minVersion
+	api.MaxVersion = maxVersion
+	api.FlexibleVersion = flexibleVersion
+	api.NewRequest = newRequest
+}
+
+// RegisterResponse registers the response message of an API. It is called by the generated
+// response types.
+func RegisterResponse(key ApiKey, newResponse func(version int) Message) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	api := lookupLocked(key)
+	if api.NewResponse != nil {
+		panic(fmt.Sprintf("protocol: response for %v registered twice", key))
+	}
+	api.NewResponse = newResponse
+}
+
+func lookupLocked(key ApiKey) *Api {
+	api, ok := registry[key]
+	if !ok {
+		api = &Api{Key: key, FlexibleVersion: -1}
+		registry[key] = api
+	}
+	return api
+}
+
+// Lookup returns the registered API with the given key. Only APIs with a registered request are
+// returned.
+func Lookup(key ApiKey) (Api, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	api, ok := registry[key]
+	if !ok || api.NewRequest == nil {
+		return Api{}, false
+	}
+	return *api, true
+}
+
+// Apis returns all registered APIs ordered by key.
+func Apis() []Api {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	apis := make([]Api, 0, len(registry))
+	for _, api := range registry {
+		if api.NewRequest != nil {
+			apis = append(apis, *api)
+		}
+	}
+	sort.Slice(apis, func(i, j int) bool { return apis[i].Key < apis[j].Key })
+	return apis
+}
@@ -0,0 +1,68 @@
+package protocol
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	newMessage := func(version int) Message { return nil }
+	RegisterRequest(1001, 1, 4, 3, newMessage)
+	RegisterResponse(1001, newMessage)
+	RegisterRequest(1000, 0, 2, -1, newMessage)
+	// an API without a request is not returned
+	RegisterResponse(1002, newMessage)
+
+	api, ok := Lookup(1001)
+	if !ok {
+		t.Fatal("Lookup(1001) found nothing")
+	}
+	if api.Key != 1001 || api.MinVersion != 1 || api.MaxVersion != 4 || api.FlexibleVersion != 3 || api.NewResponse == nil {
+		t.Errorf("Lookup(1001) = %+v", api)
+	}
+	if !api.Supports(1) || api.Supports(5) || api.IsFlexible(2) || !api.IsFlexible(3) {
+		t.Errorf("versions of %+v", api)
+	}
+	if api, _ := Lookup(1000); api.IsFlexible(2) || api.NewResponse != nil {
+		t.Errorf("Lookup(1000) = %+v", api)
+	}
+	if _, ok := Lookup(1002); ok {
+		t.Error("Lookup(1002) found an API without a request")
+	}
+
+	apis := Apis()
+	var keys []ApiKey
+	for _, api := range apis {
+		keys = append(keys, api.Key)
+	}
+	if !sort.SliceIsSorted(keys, func(i, j int) bool { return keys[i] < keys[j] }) {
+		t.Errorf("Apis() not ordered by key: %v", keys)
+	}
+	i := sort.Search(len(keys), func(i int) bool { return keys[i] >= 1000 })
+	if i+1 >= len(keys) || keys[i] != 1000 || keys[i+1] != 1001 {
+		t.Errorf("Apis() = %v, want 1000 and 1001", keys)
+	}
+}
+
+func TestRegisterTwice(t *testing.T) {
+	newMessage := func(version int) Message { return nil }
+	RegisterRequest(1010, 0, 0, -1, newMessage)
+	RegisterResponse(1010, newMessage)
+
+	for name, register := range map[string]func(){
+		"request":  func() { RegisterRequest(1010, 0, 1, -1, newMessage) },
+		"response": func() { RegisterResponse(1010, newMessage) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("registering a %s twice did not panic", name)
+				}
+			}()
+			register()
+		}()
+	}
+	if api, _ := Lookup(1010); api.MaxVersion != 0 {
+		t.Errorf("a duplicate registration changed the API to %+v", api)
+	}
+}
@@ -0,0 +1,23 @@
+package protocol
+
+import "bytes"
+
+// TaggedField is a raw field from the tagged field section of a flexible version struct (KIP-482).
+type TaggedField struct {
+	Tag  int
+	Data []byte
+}
+
+// NewTaggedField creates a tagged field from the bytes written by encode.
+func NewTaggedField(tag int, encode func(w *MessageWriter) error) (TaggedField, error) {
+	var buf bytes.Buffer
+	if err := encode(NewMessageWriter(&buf)); err != nil {
+		return TaggedField{}, err
+	}
+	return TaggedField{Tag: tag, Data: buf.Bytes()}, nil
+}
+
+// Reader returns a reader over the data of the tagged field.
+func (f TaggedField) Reader() *MessageReader {
+	return NewMessageReader(bytes.NewReader(f.Data))
+}
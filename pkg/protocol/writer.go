@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
 
 	"github.com/google/uuid"
 )
@@ -22,6 +23,13 @@ func (w *MessageWriter) Write(p []byte) (nn int, err error) {
 	return w.writer.Write(p)
 }
 
+func (w *MessageWriter) WriteBool(v bool) error {
+	if v {
+		return w.WriteInt8(1)
+	}
+	return w.WriteInt8(0)
+}
+
 func (w *MessageWriter) WriteInt8(v int8) error {
 	_, err := w.writer.Write([]byte{byte(v)})
 	return err
@@ -33,6 +41,12 @@ func (w *MessageWriter) WriteInt16(v int16) error {
 	return err
 }
 
+func (w *MessageWriter) WriteUint16(v uint16) error {
+	binary.BigEndian.PutUint16(w.buf[:2], v)
+	_, err := w.writer.Write(w.buf[:2])
+	return err
+}
+
 func (w *MessageWriter) WriteInt32(v int32) error {
 	binary.BigEndian.PutUint32(w.buf[:4], uint32(v))
 	_, err := w.writer.Write(w.buf[:4])
@@ -51,13 +65,20 @@ func (w *MessageWriter) WriteUint32(v uint32) error {
 	return err
 }
 
+func (w *MessageWriter) WriteUnsignedVarInt(v uint32) error {
+	_, err := w.writer.Write(binary.AppendUvarint(w.buf[:0], uint64(v)))
+	return err
+}
+
+// WriteVarInt writes a zigzag encoded signed varint.
 func (w *MessageWriter) WriteVarInt(v int32) error {
-	_, err := w.writer.Write(writevarint(int64(v)))
+	_, err := w.writer.Write(binary.AppendVarint(w.buf[:0], int64(v)))
 	return err
 }
 
+// WriteVarLong writes a zigzag encoded signed varlong.
 func (w *MessageWriter) WriteVarLong(v int64) error {
-	_, err := w.writer.Write(writevarint(v))
+	_, err := w.writer.Write(binary.AppendVarint(w.buf[:0], v))
 	return err
 }
 
@@ -67,7 +88,7 @@ func (w *MessageWriter) WriteUuid(v uuid.UUID) error {
 }
 
 func (w *MessageWriter) WriteFloat64(v float64) error {
-	binary.BigEndian.PutUint64(w.buf[:8], uint64(v))
+	binary.BigEndian.PutUint64(w.buf[:8], math.Float64bits(v))
 	_, err := w.writer.Write(w.buf[:8])
 	return err
 }
@@ -88,16 +109,12 @@ func (w *MessageWriter) WriteString(s string) error {
 }
 
 func (w *MessageWriter) WriteCompactString(s string) error {
-	var l int
-
-	if len(s) > 0 {
-		l = len(s) + 1
-		if l > math.MaxUint32 {
-			return fmt.Errorf("unable to write string with invalid length %d", l)
-		}
+	l := len(s) + 1
+	if l > math.MaxUint32 {
+		return fmt.Errorf("unable to write string with invalid length %d", l)
 	}
 
-	err := w.WriteVarInt(int32(l))
+	err := w.WriteUnsignedVarInt(uint32(l))
 	if err != nil {
 		return err
 	}
@@ -132,9 +149,7 @@ func (w *MessageWriter) WriteNullableString(s *string) error {
 
 func (w *MessageWriter) WriteCompactNullableString(s *string) error {
 	var l int
-	if s == nil || len(*s) == 0 {
-		l = 0
-	} else {
+	if s != nil {
 		l = len(*s) + 1
 	}
 
@@ -142,7 +157,7 @@ func (w *MessageWriter) WriteCompactNullableString(s *string) error {
 		return fmt.Errorf("unable to write string with invalid length %d", l)
 	}
 
-	err := w.WriteVarInt(int32(l))
+	err := w.WriteUnsignedVarInt(uint32(l))
 	if err != nil {
 		return err
 	}
@@ -156,11 +171,11 @@ func (w *MessageWriter) WriteCompactNullableString(s *string) error {
 
 func (w *MessageWriter) WriteBytes(b []byte) error {
 	l := len(b)
-	if l > math.MaxInt16 {
-		return fmt.Errorf("unable to write string with invalid length %d", l)
+	if l > math.MaxInt32 {
+		return fmt.Errorf("unable to write bytes with invalid length %d", l)
 	}
 
-	err := w.WriteInt16(int16(l))
+	err := w.WriteInt32(int32(l))
 	if err != nil {
 		return err
 	}
@@ -172,10 +187,10 @@ func (w *MessageWriter) WriteBytes(b []byte) error {
 func (w *MessageWriter) WriteCompactBytes(b []byte) error {
 	l := len(b) + 1
 	if l > math.MaxUint32 {
-		return fmt.Errorf("unable to write string with invalid length %d", l)
+		return fmt.Errorf("unable to write bytes with invalid length %d", l)
 	}
 
-	err := w.WriteVarInt(int32(l))
+	err := w.WriteUnsignedVarInt(uint32(l))
 	if err != nil {
 		return err
 	}
@@ -192,11 +207,11 @@ func (w *MessageWriter) WriteNullableBytes(b []byte) error {
 		l = len(b)
 	}
 
-	if l > math.MaxInt16 {
-		return fmt.Errorf("unable to write string with invalid length %d", l)
+	if l > math.MaxInt32 {
+		return fmt.Errorf("unable to write bytes with invalid length %d", l)
 	}
 
-	err := w.WriteInt16(int16(l))
+	err := w.WriteInt32(int32(l))
 	if err != nil {
 		return err
 	}
@@ -217,10 +232,10 @@ func (w *MessageWriter) WriteCompactNullableBytes(b []byte) error {
 	}
 
 	if l > math.MaxUint32 {
-		return fmt.Errorf("unable to write string with invalid length %d", l)
+		return fmt.Errorf("unable to write bytes with invalid length %d", l)
 	}
 
-	err := w.WriteVarInt(int32(l))
+	err := w.WriteUnsignedVarInt(uint32(l))
 	if err != nil {
 		return err
 	}
@@ -240,23 +255,57 @@ func (w *MessageWriter) WriteCompactRecords(b []byte) error {
 	return w.WriteCompactNullableBytes(b)
 }
 
-func writevarint(i int64) (ret []byte) {
-	for i > 0 {
-		ret = append(ret, byte(0x7F&i))
-		i >>= 7
+// WriteArrayLength writes the length of an array. A negative length denotes a null array.
+func (w *MessageWriter) WriteArrayLength(n int) error {
+	if n < 0 {
+		n = -1
+	} else if n > math.MaxInt32 {
+		return fmt.Errorf("unable to write array with invalid length %d", n)
 	}
 
-	if len(ret) == 0 {
-		ret = []byte{0}
+	return w.WriteInt32(int32(n))
+}
+
+// WriteCompactArrayLength writes the length of a compact array. A negative length denotes a null
+// array.
+func (w *MessageWriter) WriteCompactArrayLength(n int) error {
+	if n < 0 {
+		n = -1
+	} else if n >= math.MaxUint32 {
+		return fmt.Errorf("unable to write array with invalid length %d", n)
 	}
 
-	for front, back := 0, len(ret)-1; front < back; front, back = front+1, back-1 {
-		ret[front], ret[back] = ret[back], ret[front]
+	return w.WriteUnsignedVarInt(uint32(n + 1))
+}
+
+// WriteTaggedFields writes the tagged field section of a flexible version struct. Fields are
+// written in ascending tag order as required by the protocol.
+func (w *MessageWriter) WriteTaggedFields(fields []TaggedField) error {
+	sorted := make([]TaggedField, len(fields))
+	copy(sorted, fields)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Tag < sorted[j].Tag })
+
+	if err := w.WriteUnsignedVarInt(uint32(len(sorted))); err != nil {
+		return err
 	}
 
-	for i := 0; i < len(ret)-1; i++ {
-		i |= 0x80
+	for i, f := range sorted {
+		if i > 0 && f.Tag == sorted[i-1].Tag {
+			return fmt.Errorf("duplicate tagged field %d", f.Tag)
+		}
+
+		if err := w.WriteUnsignedVarInt(uint32(f.Tag)); err != nil {
+			return err
+		}
+
+		if err := w.WriteUnsignedVarInt(uint32(len(f.Data))); err != nil {
+			return err
+		}
+
+		if _, err := w.writer.Write(f.Data); err != nil {
+			return err
+		}
 	}
 
-	return
+	return nil
 }
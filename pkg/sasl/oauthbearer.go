@@ -0,0 +1,207 @@
+package sasl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrInvalidToken              = errors.New("invalid OAUTHBEARER token")
+	ErrInvalidOAuthBearerMessage = errors.New("invalid OAUTHBEARER message")
+)
+
+// oauthBearerSeparator separates the key-value pairs of OAUTHBEARER messages, and is the response
+// of the client to an error challenge.
+const oauthBearerSeparator = "\x01"
+
+var (
+	// oauthBearerTokenPattern is the b64token syntax of RFC 6750 that bearer tokens must follow.
+	oauthBearerTokenPattern = regexp.MustCompile(`^[A-Za-z0-9\-._~+/]+=*$`)
+	// extensionKeyPattern and extensionValuePattern are the syntax of the extensions of RFC 7628.
+	extensionKeyPattern   = regexp.MustCompile(`^[A-Za-z]+$`)
+	extensionValuePattern = regexp.MustCompile(`^[\x21-\x7E \t\r\n]+$`)
+)
+
+// OAuthBearerToken is a bearer token, such as a JWT, and the SASL extensions to send with it.
+type OAuthBearerToken struct {
+	Value      string
+	Extensions map[string]string
+}
+
+// TokenProvider returns the token to authenticate with. It is called for every authentication,
+// including re-authentication, so it should return a fresh token once the previous one expires.
+type TokenProvider func(ctx context.Context) (OAuthBearerToken, error)
+
+// OAuthBearer is the client side of OAUTHBEARER (RFC 7628), which authenticates with a token from
+// TokenProvider.
+type OAuthBearer struct {
+	// Authzid is the identity to act as, which is empty to act as the principal of the token.
+	Authzid       string
+	TokenProvider TokenProvider
+}
+
+func (o OAuthBearer) Name() string {
+	return "OAUTHBEARER"
+}
+
+func (o OAuthBearer) Start(ctx context.Context) (Session, []byte, error) {
+	if o.TokenProvider == nil {
+		return nil, nil, fmt.Errorf("%w: no token provider", ErrInvalidToken)
+	}
+	token, err := o.TokenProvider(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !oauthBearerTokenPattern.MatchString(token.Value) {
+		return nil, nil, ErrInvalidToken
+	}
+
+	var b strings.Builder
+	b.WriteString("n,")
+	if o.Authzid != "" {
+		b.WriteString("a=" + escapeSaslName(o.Authzid))
+	}
+	b.WriteString("," + oauthBearerSeparator + "auth=Bearer " + token.Value + oauthBearerSeparator)
+	// extensions are sorted so that the message does not depend on map order
+	keys := make([]string, 0, len(token.Extensions))
+	for key := range token.Extensions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := token.Extensions[key]
+		if key == "auth" || !extensionKeyPattern.MatchString(key) || !extensionValuePattern.MatchString(value) {
+			return nil, nil, fmt.Errorf("%w: extension %q", ErrInvalidToken, key)
+		}
+		b.WriteString(key + "=" + value + oauthBearerSeparator)
+	}
+	b.WriteString(oauthBearerSeparator)
+	return &oauthBearerClientSession{}, []byte(b.String()), nil
+}
+
+type oauthBearerClientSession struct {
+	// failed is set once the server has sent an error challenge.
+	failed bool
+}
+
+// Next completes the exchange when the server accepts the token with an empty challenge. A
+// server that rejects the token sends an error challenge instead, which the client acknowledges
+// so that the server can fail the authentication.
+func (s *oauthBearerClientSession) Next(ctx context.Context, challenge []byte) (bool, []byte, error) {
+	if len(challenge) == 0 {
+		return true, nil, nil
+	}
+	if s.failed {
+		return false, nil, fmt.Errorf("%w: %s", ErrAuthenticationFailed, challenge)
+	}
+	s.failed = true
+	return false, []byte(oauthBearerSeparator), nil
+}
+
+// OAuthBearerServer is the server side of OAUTHBEARER, which verifies tokens with Validate.
+type OAuthBearerServer struct {
+	// Validate verifies a token and its extensions, and returns the principal that it
+	// authenticates.
+	Validate func(ctx context.Context, token string, extensions map[string]string) (principal string, err error)
+}
+
+func (o OAuthBearerServer) Name() string {
+	return "OAUTHBEARER"
+}
+
+func (o OAuthBearerServer) Start(ctx context.Context) (ServerSession, error) {
+	if o.Validate == nil {
+		return nil, fmt.Errorf("%w: no token validator", ErrInvalidToken)
+	}
+	return &oauthBearerServerSession{server: o}, nil
+}
+
+type oauthBearerServerSession struct {
+	server    OAuthBearerServer
+	principal string
+	// err is set once an error challenge has been sent for an invalid token.
+	err error
+}
+
+func (s *oauthBearerServerSession) Username() string {
+	return s.principal
+}
+
+func (s *oauthBearerServerSession) Next(ctx context.Context, response []byte) (bool, []byte, error) {
+	if s.err != nil {
+		if string(response) != oauthBearerSeparator {
+			return false, nil, fmt.Errorf("%w: expected the response to an error challenge", ErrInvalidOAuthBearerMessage)
+		}
+		return false, nil, s.err
+	}
+	if s.principal != "" {
+		return false, nil, fmt.Errorf("%w: exchange is complete", ErrInvalidOAuthBearerMessage)
+	}
+
+	authzid, token, extensions, err := parseOAuthBearerClientFirst(string(response))
+	if err != nil {
+		return false, nil, err
+	}
+	principal, err := s.server.Validate(ctx, token, extensions)
+	if err == nil && authzid != "" && authzid != principal {
+		err = fmt.Errorf("authorization identity %q differs from principal %q", authzid, principal)
+	}
+	if err != nil {
+		// the error is reported once the client has acknowledged the error challenge
+		s.err = fmt.Errorf("%w: %w", ErrAuthenticationFailed, err)
+		challenge, _ := json.Marshal(map[string]string{"status": "invalid_token"})
+		return false, challenge, nil
+	}
+	s.principal = principal
+	return true, nil, nil
+}
+
+// parseOAuthBearerClientFirst parses the first client message, which is the GS2 header followed
+// by the key-value pairs of the token and its extensions.
+func parseOAuthBearerClientFirst(message string) (authzid, token string, extensions map[string]string, err error) {
+	header, pairs, ok := strings.Cut(message, oauthBearerSeparator)
+	if !ok || !strings.HasSuffix(pairs, oauthBearerSeparator+oauthBearerSeparator) {
+		return "", "", nil, fmt.Errorf("%w: first client message", ErrInvalidOAuthBearerMessage)
+	}
+	parts := strings.Split(header, ",")
+	if len(parts) != 3 || (parts[0] != "n" && parts[0] != "y") || parts[2] != "" {
+		return "", "", nil, fmt.Errorf("%w: GS2 header %q", ErrInvalidOAuthBearerMessage, header)
+	}
+	if parts[1] != "" {
+		if !strings.HasPrefix(parts[1], "a=") {
+			return "", "", nil, fmt.Errorf("%w: GS2 header %q", ErrInvalidOAuthBearerMessage, header)
+		}
+		if authzid, err = unescapeSaslName(parts[1][2:]); err != nil {
+			return "", "", nil, err
+		}
+	}
+
+	extensions = make(map[string]string)
+	for _, pair := range strings.Split(strings.TrimSuffix(pairs, oauthBearerSeparator+oauthBearerSeparator), oauthBearerSeparator) {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || !extensionKeyPattern.MatchString(key) {
+			return "", "", nil, fmt.Errorf("%w: key-value pair %q", ErrInvalidOAuthBearerMessage, pair)
+		}
+		if key == "auth" {
+			scheme, bearer, ok := strings.Cut(value, " ")
+			if !ok || !strings.EqualFold(scheme, "Bearer") || !oauthBearerTokenPattern.MatchString(bearer) {
+				return "", "", nil, fmt.Errorf("%w: auth value", ErrInvalidOAuthBearerMessage)
+			}
+			token = bearer
+			continue
+		}
+		if !extensionValuePattern.MatchString(value) {
+			return "", "", nil, fmt.Errorf("%w: extension %q", ErrInvalidOAuthBearerMessage, key)
+		}
+		extensions[key] = value
+	}
+	if token == "" {
+		return "", "", nil, fmt.Errorf("%w: missing token", ErrInvalidOAuthBearerMessage)
+	}
+	return authzid, token, extensions, nil
+}
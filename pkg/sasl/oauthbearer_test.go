@@ -0,0 +1,122 @@
+package sasl
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// jwt is a fixed unsigned JWT, as issued by a stand-in token endpoint.
+const jwt = "eyJhbGciOiJub25lIn0.eyJzdWIiOiJhbGljZSJ9."
+
+func tokenProvider(token OAuthBearerToken) TokenProvider {
+	return func(context.Context) (OAuthBearerToken, error) {
+		return token, nil
+	}
+}
+
+func TestOAuthBearerMessage(t *testing.T) {
+	client := OAuthBearer{
+		Authzid: "alice",
+		TokenProvider: tokenProvider(OAuthBearerToken{
+			Value:      jwt,
+			Extensions: map[string]string{"traceId": "abc", "logicalCluster": "lkc-1"},
+		}),
+	}
+	_, message, err := client.Start(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// extensions follow the token, sorted by key
+	want := "n,a=alice,\x01auth=Bearer " + jwt + "\x01logicalCluster=lkc-1\x01traceId=abc\x01\x01"
+	if string(message) != want {
+		t.Errorf("message = %q, want %q", message, want)
+	}
+
+	authzid, token, extensions, err := parseOAuthBearerClientFirst(string(message))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authzid != "alice" || token != jwt || !reflect.DeepEqual(extensions, map[string]string{"traceId": "abc", "logicalCluster": "lkc-1"}) {
+		t.Errorf("parsed %q, %q, %v", authzid, token, extensions)
+	}
+}
+
+func TestOAuthBearerInvalidToken(t *testing.T) {
+	for _, token := range []OAuthBearerToken{
+		{Value: "not a token"},
+		{Value: jwt, Extensions: map[string]string{"auth": "x"}},
+		{Value: jwt, Extensions: map[string]string{"trace_id": "x"}},
+		{Value: jwt, Extensions: map[string]string{"traceId": "\x01"}},
+	} {
+		client := OAuthBearer{TokenProvider: tokenProvider(token)}
+		if _, _, err := client.Start(context.Background()); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%+v: error = %v, want ErrInvalidToken", token, err)
+		}
+	}
+}
+
+func oauthBearerServer(principals map[string]string) OAuthBearerServer {
+	return OAuthBearerServer{
+		Validate: func(_ context.Context, token string, _ map[string]string) (string, error) {
+			principal, ok := principals[token]
+			if !ok {
+				return "", errors.New("unknown token")
+			}
+			return principal, nil
+		},
+	}
+}
+
+func TestOAuthBearer(t *testing.T) {
+	server := oauthBearerServer(map[string]string{jwt: "alice"})
+
+	session, err := exchange(t, OAuthBearer{TokenProvider: tokenProvider(OAuthBearerToken{Value: jwt})}, server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Username() != "alice" {
+		t.Errorf("Username() = %q, want alice", session.Username())
+	}
+
+	client := OAuthBearer{Authzid: "bob", TokenProvider: tokenProvider(OAuthBearerToken{Value: jwt})}
+	if _, err := exchange(t, client, server); !errors.Is(err, ErrAuthenticationFailed) {
+		t.Errorf("other authzid: error = %v, want ErrAuthenticationFailed", err)
+	}
+}
+
+// TestOAuthBearerErrorChallenge checks the round that rejects a token: the server sends an error
+// challenge, the client acknowledges it with a single separator, and the server then fails.
+func TestOAuthBearerErrorChallenge(t *testing.T) {
+	ctx := context.Background()
+	client := OAuthBearer{TokenProvider: tokenProvider(OAuthBearerToken{Value: "expired"})}
+	clientSession, message, err := client.Start(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverSession, err := oauthBearerServer(nil).Start(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done, challenge, err := serverSession.Next(ctx, message)
+	if done || err != nil || string(challenge) != `{"status":"invalid_token"}` {
+		t.Fatalf("server Next() = %v, %q, %v, want an error challenge", done, challenge, err)
+	}
+	done, response, err := clientSession.Next(ctx, challenge)
+	if done || err != nil || string(response) != "\x01" {
+		t.Fatalf("client Next() = %v, %q, %v, want \\x01", done, response, err)
+	}
+	if _, _, err := serverSession.Next(ctx, response); !errors.Is(err, ErrAuthenticationFailed) {
+		t.Errorf("server error = %v, want ErrAuthenticationFailed", err)
+	}
+	if serverSession.Username() != "" {
+		t.Errorf("Username() = %q after a failed exchange", serverSession.Username())
+	}
+
+	// a server that sends a second challenge fails the client
+	if _, _, err := clientSession.Next(ctx, challenge); !errors.Is(err, ErrAuthenticationFailed) {
+		t.Errorf("client error = %v, want ErrAuthenticationFailed", err)
+	}
+}
@@ -0,0 +1,80 @@
+package sasl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// Plain is the PLAIN mechanism of RFC 4616, which sends the username and password in the clear.
+// It should only be used over TLS.
+type Plain struct {
+	// Authzid is the identity to act as, which is empty to act as Username.
+	Authzid  string
+	Username string
+	Password string
+}
+
+func (p Plain) Name() string {
+	return "PLAIN"
+}
+
+func (p Plain) Start(ctx context.Context) (Session, []byte, error) {
+	if p.Username == "" || strings.ContainsRune(p.Authzid+p.Username+p.Password, 0) {
+		return nil, nil, ErrInvalidCredentials
+	}
+	return p, []byte(p.Authzid + "\x00" + p.Username + "\x00" + p.Password), nil
+}
+
+// Next completes the exchange, as the broker sends no challenge for PLAIN.
+func (p Plain) Next(ctx context.Context, challenge []byte) (bool, []byte, error) {
+	return true, nil, nil
+}
+
+// PlainServer is the server side of PLAIN, which verifies passwords with Authenticate.
+type PlainServer struct {
+	// Authenticate verifies the password of a user.
+	Authenticate func(ctx context.Context, username, password string) error
+}
+
+func (p PlainServer) Name() string {
+	return "PLAIN"
+}
+
+func (p PlainServer) Start(ctx context.Context) (ServerSession, error) {
+	if p.Authenticate == nil {
+		return nil, errors.New("no password verifier")
+	}
+	return &plainServerSession{server: p}, nil
+}
+
+type plainServerSession struct {
+	server   PlainServer
+	username string
+}
+
+func (s *plainServerSession) Username() string {
+	return s.username
+}
+
+// Next verifies the only message of the client, which is the authzid, username and password
+// separated by NUL bytes.
+func (s *plainServerSession) Next(ctx context.Context, response []byte) (bool, []byte, error) {
+	parts := strings.Split(string(response), "\x00")
+	if len(parts) != 3 || parts[1] == "" {
+		return false, nil, ErrInvalidCredentials
+	}
+	authzid, username, password := parts[0], parts[1], parts[2]
+	// as in Kafka, clients can only act as themselves
+	if authzid != "" && authzid != username {
+		return false, nil, fmt.Errorf("%w: authorization identity %q differs from username %q", ErrInvalidCredentials, authzid, username)
+	}
+	if err := s.server.Authenticate(ctx, username, password); err != nil {
+		return false, nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+	s.username = username
+	return true, nil, nil
+}
@@ -0,0 +1,37 @@
+// Package sasl implements the SASL mechanisms that Kafka brokers use to authenticate clients.
+package sasl
+
+import "context"
+
+// Mechanism is a SASL mechanism, such as PLAIN.
+type Mechanism interface {
+	// Name returns the name of the mechanism sent in SaslHandshake requests.
+	Name() string
+	// Start begins an authentication exchange. It returns the session that answers the challenges
+	// of the broker, and the initial response of the client.
+	Start(ctx context.Context) (Session, []byte, error)
+}
+
+// Session is an authentication exchange of a Mechanism.
+type Session interface {
+	// Next answers a challenge from the broker. It returns done once the exchange is complete, and
+	// otherwise the response to send.
+	Next(ctx context.Context, challenge []byte) (done bool, response []byte, err error)
+}
+
+// ServerMechanism is the broker side of a Mechanism.
+type ServerMechanism interface {
+	// Name returns the name of the mechanism that clients request in SaslHandshake requests.
+	Name() string
+	// Start begins an authentication exchange with a client.
+	Start(ctx context.Context) (ServerSession, error)
+}
+
+// ServerSession is the broker side of an authentication exchange.
+type ServerSession interface {
+	// Next processes a response from the client and returns the challenge to send. It returns done
+	// once the client is authenticated, with the final challenge, if any.
+	Next(ctx context.Context, response []byte) (done bool, challenge []byte, err error)
+	// Username returns the user that the client authenticated as.
+	Username() string
+}
@@ -0,0 +1,408 @@
+package sasl
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrAuthenticationFailed = errors.New("authentication failed")
+	ErrInvalidScramMessage  = errors.New("invalid SCRAM message")
+)
+
+// MinScramIterations is the smallest iteration count that Kafka accepts for SCRAM credentials.
+const MinScramIterations = 4096
+
+// ScramMechanism is a SCRAM mechanism, with the id used by the SCRAM credential APIs.
+type ScramMechanism int8
+
+const (
+	ScramSha256 ScramMechanism = 1
+	ScramSha512 ScramMechanism = 2
+)
+
+func (m ScramMechanism) String() string {
+	switch m {
+	case ScramSha256:
+		return "SCRAM-SHA-256"
+	case ScramSha512:
+		return "SCRAM-SHA-512"
+	}
+	return "SCRAM-" + strconv.Itoa(int(m))
+}
+
+// ScramMechanismByName returns the SCRAM mechanism with a name such as SCRAM-SHA-256.
+func ScramMechanismByName(name string) (ScramMechanism, bool) {
+	for _, m := range []ScramMechanism{ScramSha256, ScramSha512} {
+		if m.String() == name {
+			return m, true
+		}
+	}
+	return 0, false
+}
+
+func (m ScramMechanism) hash() (func() hash.Hash, error) {
+	switch m {
+	case ScramSha256:
+		return sha256.New, nil
+	case ScramSha512:
+		return sha512.New, nil
+	}
+	return nil, fmt.Errorf("unknown SCRAM mechanism %d", int8(m))
+}
+
+// SaltPassword derives the salted password of RFC 5802, which is PBKDF2 of the password with the
+// hash of the mechanism. It is what AlterUserScramCredentials requests carry.
+func (m ScramMechanism) SaltPassword(password string, salt []byte, iterations int) ([]byte, error) {
+	h, err := m.hash()
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(h, []byte(password))
+	mac.Write(salt)
+	mac.Write([]byte{0, 0, 0, 1})
+	u := mac.Sum(nil)
+	result := append([]byte(nil), u...)
+	for i := 1; i < iterations; i++ {
+		mac.Reset()
+		mac.Write(u)
+		u = mac.Sum(u[:0])
+		for j := range result {
+			result[j] ^= u[j]
+		}
+	}
+	return result, nil
+}
+
+// ScramCredential is what a server stores to verify the password of a SCRAM user, without storing
+// the password.
+type ScramCredential struct {
+	Mechanism  ScramMechanism
+	Salt       []byte
+	Iterations int
+	StoredKey  []byte
+	ServerKey  []byte
+}
+
+// NewScramCredential derives the credential for a password.
+func NewScramCredential(mechanism ScramMechanism, password string, salt []byte, iterations int) (ScramCredential, error) {
+	salted, err := mechanism.SaltPassword(password, salt, iterations)
+	if err != nil {
+		return ScramCredential{}, err
+	}
+	return ScramCredentialFromSaltedPassword(mechanism, salt, salted, iterations)
+}
+
+// ScramCredentialFromSaltedPassword derives the credential for a salted password, as sent in
+// AlterUserScramCredentials requests.
+func ScramCredentialFromSaltedPassword(mechanism ScramMechanism, salt, saltedPassword []byte, iterations int) (ScramCredential, error) {
+	h, err := mechanism.hash()
+	if err != nil {
+		return ScramCredential{}, err
+	}
+	clientKey := hmacSum(h, saltedPassword, "Client Key")
+	storedKey := h()
+	storedKey.Write(clientKey)
+	return ScramCredential{
+		Mechanism:  mechanism,
+		Salt:       salt,
+		Iterations: iterations,
+		StoredKey:  storedKey.Sum(nil),
+		ServerKey:  hmacSum(h, saltedPassword, "Server Key"),
+	}, nil
+}
+
+// Scram is the client side of SCRAM (RFC 5802) with SHA-256 or SHA-512.
+type Scram struct {
+	Mechanism ScramMechanism
+	Username  string
+	Password  string
+}
+
+func (s Scram) Name() string {
+	return s.Mechanism.String()
+}
+
+func (s Scram) Start(ctx context.Context) (Session, []byte, error) {
+	h, err := s.Mechanism.hash()
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce, err := newNonce()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	session := &scramClientSession{
+		scram:           s,
+		hash:            h,
+		nonce:           nonce,
+		clientFirstBare: "n=" + escapeSaslName(s.Username) + ",r=" + nonce,
+	}
+	return session, []byte(scramGs2Header + session.clientFirstBare), nil
+}
+
+// scramGs2Header is the header of the first client message, for clients that do not support
+// channel binding and do not send an authorization identity.
+const scramGs2Header = "n,,"
+
+type scramClientSession struct {
+	scram           Scram
+	hash            func() hash.Hash
+	nonce           string
+	clientFirstBare string
+	// serverSignature is set once the final client message has been sent.
+	serverSignature []byte
+}
+
+func (s *scramClientSession) Next(ctx context.Context, challenge []byte) (bool, []byte, error) {
+	if s.serverSignature != nil {
+		return true, nil, s.verifyServerFinal(string(challenge))
+	}
+
+	serverFirst := string(challenge)
+	attrs, err := parseScramAttributes(serverFirst)
+	if err != nil {
+		return false, nil, err
+	}
+	nonce, salt, iterations := attrs["r"], attrs["s"], attrs["i"]
+	if !strings.HasPrefix(nonce, s.nonce) || len(nonce) == len(s.nonce) {
+		return false, nil, fmt.Errorf("%w: server nonce does not extend the client nonce", ErrInvalidScramMessage)
+	}
+	decodedSalt, err := base64.StdEncoding.DecodeString(salt)
+	if err != nil || len(decodedSalt) == 0 {
+		return false, nil, fmt.Errorf("%w: salt %q", ErrInvalidScramMessage, salt)
+	}
+	count, err := strconv.Atoi(iterations)
+	if err != nil || count < MinScramIterations {
+		return false, nil, fmt.Errorf("%w: iteration count %q", ErrInvalidScramMessage, iterations)
+	}
+
+	salted, err := s.scram.Mechanism.SaltPassword(s.scram.Password, decodedSalt, count)
+	if err != nil {
+		return false, nil, err
+	}
+	clientKey := hmacSum(s.hash, salted, "Client Key")
+	storedKey := s.hash()
+	storedKey.Write(clientKey)
+
+	clientFinal := "c=" + base64.StdEncoding.EncodeToString([]byte(scramGs2Header)) + ",r=" + nonce
+	authMessage := s.clientFirstBare + "," + serverFirst + "," + clientFinal
+	proof := hmacSum(s.hash, storedKey.Sum(nil), authMessage)
+	for i := range proof {
+		proof[i] ^= clientKey[i]
+	}
+	s.serverSignature = hmacSum(s.hash, hmacSum(s.hash, salted, "Server Key"), authMessage)
+
+	return false, []byte(clientFinal + ",p=" + base64.StdEncoding.EncodeToString(proof)), nil
+}
+
+func (s *scramClientSession) verifyServerFinal(serverFinal string) error {
+	attrs, err := parseScramAttributes(serverFinal)
+	if err != nil {
+		return err
+	}
+	if e, ok := attrs["e"]; ok {
+		return fmt.Errorf("%w: %s", ErrAuthenticationFailed, e)
+	}
+	signature, err := base64.StdEncoding.DecodeString(attrs["v"])
+	if err != nil || !hmac.Equal(signature, s.serverSignature) {
+		return fmt.Errorf("%w: invalid server signature", ErrAuthenticationFailed)
+	}
+	return nil
+}
+
+// ScramServer is the server side of SCRAM, which verifies clients against stored credentials.
+type ScramServer struct {
+	Mechanism ScramMechanism
+	// Credentials returns the credential of a user for the mechanism, and false if the user has
+	// none.
+	Credentials func(username string) (ScramCredential, bool)
+}
+
+func (s ScramServer) Name() string {
+	return s.Mechanism.String()
+}
+
+func (s ScramServer) Start(ctx context.Context) (ServerSession, error) {
+	h, err := s.Mechanism.hash()
+	if err != nil {
+		return nil, err
+	}
+	return &scramServerSession{server: s, hash: h}, nil
+}
+
+type scramServerSession struct {
+	server     ScramServer
+	hash       func() hash.Hash
+	username   string
+	credential ScramCredential
+	gs2Header  string
+	// clientFirstBare and serverFirst are set once the first client message has been answered.
+	clientFirstBare string
+	serverFirst     string
+	nonce           string
+	authenticated   bool
+}
+
+func (s *scramServerSession) Username() string {
+	if !s.authenticated {
+		return ""
+	}
+	return s.username
+}
+
+func (s *scramServerSession) Next(ctx context.Context, response []byte) (bool, []byte, error) {
+	if s.serverFirst == "" {
+		challenge, err := s.clientFirst(string(response))
+		return false, challenge, err
+	}
+	challenge, err := s.clientFinal(string(response))
+	if err != nil {
+		return false, nil, err
+	}
+	return true, challenge, nil
+}
+
+func (s *scramServerSession) clientFirst(message string) ([]byte, error) {
+	// gs2-header: a channel binding flag, an optional authorization identity and the bare message
+	parts := strings.SplitN(message, ",", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: first client message %q", ErrInvalidScramMessage, message)
+	}
+	if parts[0] != "n" && parts[0] != "y" {
+		return nil, fmt.Errorf("%w: channel binding is not supported", ErrInvalidScramMessage)
+	}
+	s.gs2Header = parts[0] + "," + parts[1] + ","
+	s.clientFirstBare = parts[2]
+
+	attrs, err := parseScramAttributes(s.clientFirstBare)
+	if err != nil {
+		return nil, err
+	}
+	username, err := unescapeSaslName(attrs["n"])
+	if err != nil {
+		return nil, err
+	}
+	if authzid := strings.TrimPrefix(parts[1], "a="); parts[1] != "" && authzid != attrs["n"] {
+		return nil, fmt.Errorf("%w: authorization identity %q differs from username", ErrAuthenticationFailed, authzid)
+	}
+	if attrs["r"] == "" {
+		return nil, fmt.Errorf("%w: missing client nonce", ErrInvalidScramMessage)
+	}
+
+	credential, ok := s.server.Credentials(username)
+	if !ok || credential.Mechanism != s.server.Mechanism {
+		return nil, fmt.Errorf("%w: unknown user %q", ErrAuthenticationFailed, username)
+	}
+	nonce, err := newNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	s.username, s.credential = username, credential
+	s.nonce = attrs["r"] + nonce
+	s.serverFirst = "r=" + s.nonce + ",s=" + base64.StdEncoding.EncodeToString(credential.Salt) + ",i=" + strconv.Itoa(credential.Iterations)
+	return []byte(s.serverFirst), nil
+}
+
+func (s *scramServerSession) clientFinal(message string) ([]byte, error) {
+	i := strings.LastIndex(message, ",p=")
+	if i < 0 {
+		return nil, fmt.Errorf("%w: missing client proof", ErrInvalidScramMessage)
+	}
+	withoutProof := message[:i]
+	attrs, err := parseScramAttributes(message)
+	if err != nil {
+		return nil, err
+	}
+	if attrs["c"] != base64.StdEncoding.EncodeToString([]byte(s.gs2Header)) {
+		return nil, fmt.Errorf("%w: channel binding does not match", ErrInvalidScramMessage)
+	}
+	if attrs["r"] != s.nonce {
+		return nil, fmt.Errorf("%w: nonce does not match", ErrInvalidScramMessage)
+	}
+	proof, err := base64.StdEncoding.DecodeString(attrs["p"])
+	if err != nil || len(proof) != len(s.credential.StoredKey) {
+		return nil, fmt.Errorf("%w: client proof", ErrInvalidScramMessage)
+	}
+
+	authMessage := s.clientFirstBare + "," + s.serverFirst + "," + withoutProof
+	clientKey := hmacSum(s.hash, s.credential.StoredKey, authMessage)
+	for i := range clientKey {
+		clientKey[i] ^= proof[i]
+	}
+	storedKey := s.hash()
+	storedKey.Write(clientKey)
+	if subtle.ConstantTimeCompare(storedKey.Sum(nil), s.credential.StoredKey) != 1 {
+		return nil, fmt.Errorf("%w: invalid credentials for user %q", ErrAuthenticationFailed, s.username)
+	}
+
+	s.authenticated = true
+	signature := hmacSum(s.hash, s.credential.ServerKey, authMessage)
+	return []byte("v=" + base64.StdEncoding.EncodeToString(signature)), nil
+}
+
+func hmacSum(h func() hash.Hash, key []byte, message string) []byte {
+	mac := hmac.New(h, key)
+	mac.Write([]byte(message))
+	return mac.Sum(nil)
+}
+
+func newNonce() (string, error) {
+	var b [24]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return base64.RawStdEncoding.EncodeToString(b[:]), nil
+}
+
+// parseScramAttributes parses the comma separated attributes of a SCRAM message, such as
+// "r=nonce,s=salt,i=4096".
+func parseScramAttributes(message string) (map[string]string, error) {
+	attrs := make(map[string]string)
+	for _, attr := range strings.Split(message, ",") {
+		if len(attr) < 2 || attr[1] != '=' {
+			return nil, fmt.Errorf("%w: attribute %q", ErrInvalidScramMessage, attr)
+		}
+		attrs[attr[:1]] = attr[2:]
+	}
+	return attrs, nil
+}
+
+var saslNameEscaper = strings.NewReplacer("=", "=3D", ",", "=2C")
+
+func escapeSaslName(name string) string {
+	return saslNameEscaper.Replace(name)
+}
+
+func unescapeSaslName(name string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		if name[i] != '=' {
+			b.WriteByte(name[i])
+			continue
+		}
+		switch {
+		case strings.HasPrefix(name[i:], "=3D"):
+			b.WriteByte('=')
+		case strings.HasPrefix(name[i:], "=2C"):
+			b.WriteByte(',')
+		default:
+			return "", fmt.Errorf("%w: username %q", ErrInvalidScramMessage, name)
+		}
+		i += 2
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,81 @@
+package sasl
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// exchange runs a client session against a server session until either is done or fails.
+func exchange(t *testing.T, client Mechanism, server ServerMechanism) (ServerSession, error) {
+	t.Helper()
+	ctx := context.Background()
+	clientSession, response, err := client.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	serverSession, err := server.Start(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		serverDone, challenge, err := serverSession.Next(ctx, response)
+		if err != nil {
+			return serverSession, err
+		}
+		clientDone, next, err := clientSession.Next(ctx, challenge)
+		if err != nil {
+			return serverSession, err
+		}
+		if serverDone && clientDone {
+			return serverSession, nil
+		}
+		response = next
+	}
+}
+
+func scramServer(t *testing.T, mechanism ScramMechanism, iterations int) ScramServer {
+	t.Helper()
+	credential, err := NewScramCredential(mechanism, "secret", []byte("salt"), iterations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ScramServer{
+		Mechanism: mechanism,
+		Credentials: func(username string) (ScramCredential, bool) {
+			return credential, username == "alice"
+		},
+	}
+}
+
+func TestScram(t *testing.T) {
+	for _, mechanism := range []ScramMechanism{ScramSha256, ScramSha512} {
+		server := scramServer(t, mechanism, MinScramIterations)
+
+		session, err := exchange(t, Scram{Mechanism: mechanism, Username: "alice", Password: "secret"}, server)
+		if err != nil {
+			t.Fatalf("%v: %v", mechanism, err)
+		}
+		if session.Username() != "alice" {
+			t.Errorf("%v: Username() = %q", mechanism, session.Username())
+		}
+
+		for _, client := range []Scram{
+			{Mechanism: mechanism, Username: "alice", Password: "guess"},
+			{Mechanism: mechanism, Username: "bob", Password: "secret"},
+		} {
+			if _, err := exchange(t, client, server); !errors.Is(err, ErrAuthenticationFailed) {
+				t.Errorf("%v %s/%s: error = %v, want ErrAuthenticationFailed", mechanism, client.Username, client.Password, err)
+			}
+		}
+	}
+}
+
+func TestScramWeakIterations(t *testing.T) {
+	// a server that asks for few iterations makes the proof of the client cheap to brute force
+	server := scramServer(t, ScramSha256, MinScramIterations-1)
+	_, err := exchange(t, Scram{Mechanism: ScramSha256, Username: "alice", Password: "secret"}, server)
+	if !errors.Is(err, ErrInvalidScramMessage) {
+		t.Errorf("error = %v, want ErrInvalidScramMessage", err)
+	}
+}